@@ -0,0 +1,61 @@
+package main
+
+import (
+	"kusionstack.io/kusion-module-framework/pkg/module"
+	apiv1 "kusionstack.io/kusion/pkg/apis/api.kusion.io/v1"
+	"kusionstack.io/kusion/pkg/modules"
+)
+
+var alicloudOSSBucketReplication = "alicloud_oss_bucket_replication"
+
+// generateAlicloudBucketBackup generates the cross-region replication resources for the bucket.
+// Replication requires bucket versioning: GenerateAlicloudResources turns on Versioning for the source
+// bucket before calling this, and a destination bucket created here has versioning enabled.
+func (objectStorage *ObjectStorage) generateAlicloudBucketBackup(alicloudProviderCfg module.ProviderConfig, ossBucketID string) ([]apiv1.Resource, error) {
+	var resources []apiv1.Resource
+
+	destinationBucketName := objectStorage.Backup.DestinationBucket
+	if destinationBucketName == "" {
+		destinationBucketName = objectStorage.Bucket + "-replica"
+
+		destinationProviderCfg := alicloudProviderCfg
+		destinationProviderCfg.ProviderMeta = map[string]any{"region": objectStorage.Backup.DestinationRegion}
+
+		resAttrs := map[string]interface{}{
+			"bucket":     destinationBucketName,
+			"versioning": map[string]interface{}{"status": "Enabled"},
+		}
+
+		id, err := module.TerraformResourceID(destinationProviderCfg, alicloudOSSBucket, destinationBucketName)
+		if err != nil {
+			return nil, err
+		}
+
+		destinationBucket, err := module.WrapTFResourceToKusionResource(destinationProviderCfg, alicloudOSSBucket, id, resAttrs, nil)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, *destinationBucket)
+	}
+
+	replicationAttrs := map[string]interface{}{
+		"bucket": modules.KusionPathDependency(ossBucketID, "bucket"),
+		"destination": map[string]interface{}{
+			"bucket":   destinationBucketName,
+			"location": objectStorage.Backup.DestinationRegion,
+		},
+	}
+
+	id, err := module.TerraformResourceID(alicloudProviderCfg, alicloudOSSBucketReplication, objectStorage.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	replication, err := module.WrapTFResourceToKusionResource(alicloudProviderCfg, alicloudOSSBucketReplication, id, replicationAttrs, nil)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, *replication)
+
+	return resources, nil
+}
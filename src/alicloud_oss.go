@@ -0,0 +1,338 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"kusionstack.io/kusion-module-framework/pkg/module"
+	apiv1 "kusionstack.io/kusion/pkg/apis/api.kusion.io/v1"
+	"kusionstack.io/kusion/pkg/modules"
+)
+
+var ErrEmptyAlicloudProviderRegion = errors.New("empty alicloud provider region")
+
+var (
+	alicloudRegionEnv                  = "ALICLOUD_REGION"
+	alicloudOSSBucket                  = "alicloud_oss_bucket"
+	alicloudOSSBucketPublicAccessBlock = "alicloud_oss_bucket_public_access_block"
+	alicloudRAMPolicy                  = "alicloud_ram_policy"
+	alicloudRAMRole                    = "alicloud_ram_role"
+)
+
+// alicloudAccessActions maps an Access.Mode to the OSS actions granted to the bucket.
+var alicloudAccessActions = map[string][]string{
+	"readwrite": {"oss:GetObject", "oss:PutObject", "oss:DeleteObject", "oss:ListObjects"},
+	"readonly":  {"oss:GetObject", "oss:ListObjects"},
+	"writeonly": {"oss:PutObject"},
+}
+
+var defaultAlicloudProviderCfg = module.ProviderConfig{
+	Source:  "aliyun/alicloud",
+	Version: "1.221.0",
+}
+
+// GenerateAlicloudResources generates the Alicloud provided ObjectStorage OSS bucket instance.
+func (objectStorage *ObjectStorage) GenerateAlicloudResources(request *module.GeneratorRequest) ([]apiv1.Resource, *apiv1.Patcher, error) {
+	var resources []apiv1.Resource
+
+	// Set the Alicloud provider with the default provider config.
+	alicloudProviderCfg := defaultAlicloudProviderCfg
+
+	// Get the Alicloud Terraform provider region, which should not be empty.
+	var region string
+	if region = module.TerraformProviderRegion(alicloudProviderCfg); region == "" {
+		region = os.Getenv(alicloudRegionEnv)
+	}
+	if region == "" {
+		return nil, nil, ErrEmptyAlicloudProviderRegion
+	}
+
+	if objectStorage.Backup != nil {
+		objectStorage.Versioning = true
+	}
+
+	ossBucket, ossBucketID, err := objectStorage.generateAlicloudOSSBucket(alicloudProviderCfg, region)
+	if err != nil {
+		return nil, nil, err
+	}
+	resources = append(resources, *ossBucket)
+
+	if objectStorage.Backup != nil {
+		backupResources, err := objectStorage.generateAlicloudBucketBackup(alicloudProviderCfg, ossBucketID)
+		if err != nil {
+			return nil, nil, err
+		}
+		resources = append(resources, backupResources...)
+	}
+
+	if objectStorage.PublicAccessBlock {
+		publicAccessBlock, err := objectStorage.generateAlicloudOSSBucketPublicAccessBlock(alicloudProviderCfg, ossBucketID)
+		if err != nil {
+			return nil, nil, err
+		}
+		resources = append(resources, *publicAccessBlock)
+	}
+
+	bucketEndpoint := modules.KusionPathDependency(ossBucketID, "extranet_endpoint")
+
+	envVars := []v1.EnvVar{
+		{
+			Name:  "KUSION_ALICLOUD_OSS_BUCKET_ENDPOINT",
+			Value: bucketEndpoint,
+		},
+	}
+	patcher := &apiv1.Patcher{
+		Environments: envVars,
+	}
+
+	if objectStorage.Access != nil {
+		accessResources, roleName, err := objectStorage.generateAlicloudBucketAccess(request, alicloudProviderCfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		resources = append(resources, accessResources...)
+
+		if objectStorage.Access.UseIRSA {
+			patcher.Annotations = map[string]string{
+				"pod-identity.alibabacloud.com/role-name": roleName,
+			}
+		}
+	}
+
+	return resources, patcher, nil
+}
+
+// generateAlicloudBucketAccess generates the least-privilege alicloud_ram_policy and alicloud_ram_role
+// resources granting workloads access to the bucket, using an RRSA trust relationship when
+// Access.UseIRSA is set.
+func (objectStorage *ObjectStorage) generateAlicloudBucketAccess(request *module.GeneratorRequest, alicloudProviderCfg module.ProviderConfig) ([]apiv1.Resource, string, error) {
+	mode := strings.ToLower(objectStorage.Access.Mode)
+	if mode == "" {
+		mode = "readwrite"
+	}
+
+	bucketArn := "acs:oss:*:*:" + objectStorage.Bucket
+
+	document, err := json.Marshal(map[string]interface{}{
+		"Version": "1",
+		"Statement": []map[string]interface{}{
+			{
+				"Effect":   "Allow",
+				"Action":   alicloudAccessActions[mode],
+				"Resource": []string{bucketArn, bucketArn + "/*"},
+			},
+		},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	policyAttrs := map[string]interface{}{
+		"name":     objectStorage.Bucket + "-access",
+		"document": string(document),
+	}
+
+	policyID, err := module.TerraformResourceID(alicloudProviderCfg, alicloudRAMPolicy, objectStorage.Bucket)
+	if err != nil {
+		return nil, "", err
+	}
+
+	policy, err := module.WrapTFResourceToKusionResource(alicloudProviderCfg, alicloudRAMPolicy, policyID, policyAttrs, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	roleName := objectStorage.Bucket + "-access-role"
+	roleAttrs := map[string]interface{}{
+		"name": roleName,
+	}
+	if objectStorage.Access.UseIRSA {
+		serviceAccount := request.Project + ":" + objectStorage.Access.ServiceAccountName
+		roleAttrs["assume_role_policy_document"] = alicloudRRSAAssumeRolePolicy(objectStorage.Access.OIDCProvider, serviceAccount)
+	} else {
+		roleAttrs["assume_role_policy_document"] = alicloudPrincipalAssumeRolePolicy(objectStorage.Access.Principals)
+	}
+
+	roleID, err := module.TerraformResourceID(alicloudProviderCfg, alicloudRAMRole, objectStorage.Bucket)
+	if err != nil {
+		return nil, "", err
+	}
+
+	role, err := module.WrapTFResourceToKusionResource(alicloudProviderCfg, alicloudRAMRole, roleID, roleAttrs, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return []apiv1.Resource{*policy, *role}, roleName, nil
+}
+
+// alicloudPrincipalAssumeRolePolicy builds the RAM assume-role policy document trusting the
+// configured Access.Principals directly, mirroring the non-IRSA branch of generateAWSIAMRole.
+func alicloudPrincipalAssumeRolePolicy(principals []string) string {
+	document, _ := json.Marshal(map[string]interface{}{
+		"Version": "1",
+		"Statement": []map[string]interface{}{
+			{
+				"Effect": "Allow",
+				"Principal": map[string]interface{}{
+					"RAM": principals,
+				},
+				"Action": []string{"sts:AssumeRole"},
+			},
+		},
+	})
+
+	return string(document)
+}
+
+// alicloudRRSAAssumeRolePolicy builds the RAM assume-role policy document trusting the cluster's
+// RRSA OIDC identity provider, restricted to the given "<namespace>:<name>" Kubernetes ServiceAccount.
+func alicloudRRSAAssumeRolePolicy(oidcProvider, serviceAccount string) string {
+	document, _ := json.Marshal(map[string]interface{}{
+		"Version": "1",
+		"Statement": []map[string]interface{}{
+			{
+				"Effect": "Allow",
+				"Principal": map[string]interface{}{
+					"Federated": []string{oidcProvider},
+				},
+				"Action": []string{"sts:AssumeRole", "sts:AssumeRoleWithOIDC"},
+				"Condition": map[string]interface{}{
+					"StringEquals": map[string]interface{}{
+						"oidc:sub": "system:serviceaccount:" + serviceAccount,
+					},
+				},
+			},
+		},
+	})
+
+	return string(document)
+}
+
+// generateAlicloudOSSBucket generates alicloud_oss_bucket resource for the Alicloud provided ObjectStorage bucket instance.
+func (objectStorage *ObjectStorage) generateAlicloudOSSBucket(alicloudProviderCfg module.ProviderConfig, region string) (*apiv1.Resource, string, error) {
+	resAttrs := map[string]interface{}{
+		"bucket": objectStorage.Bucket,
+	}
+	if len(objectStorage.Tags) > 0 {
+		resAttrs["tags"] = objectStorage.Tags
+	}
+	if objectStorage.Versioning {
+		resAttrs["versioning"] = map[string]interface{}{
+			"status": "Enabled",
+		}
+	}
+	if objectStorage.Encryption != nil {
+		sseAlgorithm := "AES256"
+		if objectStorage.Encryption.SSEAlgorithm == "aws:kms" {
+			sseAlgorithm = "KMS"
+		}
+		sseRule := map[string]interface{}{
+			"sse_algorithm": sseAlgorithm,
+		}
+		if objectStorage.Encryption.KMSKeyID != "" {
+			sseRule["kms_master_key_id"] = objectStorage.Encryption.KMSKeyID
+		}
+		resAttrs["server_side_encryption_rule"] = sseRule
+	}
+	if len(objectStorage.Lifecycle) > 0 {
+		resAttrs["lifecycle_rule"] = alicloudOSSLifecycleRules(objectStorage.Lifecycle)
+	}
+	if len(objectStorage.CORS) > 0 {
+		resAttrs["cors_rule"] = alicloudOSSCORSRules(objectStorage.CORS)
+	}
+	if objectStorage.Logging != nil {
+		resAttrs["logging"] = map[string]interface{}{
+			"target_bucket": objectStorage.Logging.TargetBucket,
+			"target_prefix": objectStorage.Logging.TargetPrefix,
+		}
+	}
+
+	id, err := module.TerraformResourceID(alicloudProviderCfg, alicloudOSSBucket, objectStorage.Bucket)
+	if err != nil {
+		return nil, "", err
+	}
+
+	alicloudProviderCfg.ProviderMeta = map[string]any{"region": region}
+	resource, err := module.WrapTFResourceToKusionResource(alicloudProviderCfg, alicloudOSSBucket, id, resAttrs, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return resource, id, nil
+}
+
+// alicloudOSSLifecycleRules translates the provider-agnostic LifecycleRule configs into
+// alicloud_oss_bucket lifecycle_rule blocks.
+func alicloudOSSLifecycleRules(rules []LifecycleRule) []map[string]interface{} {
+	ossRules := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		ruleAttrs := map[string]interface{}{
+			"id":      rule.ID,
+			"prefix":  rule.Prefix,
+			"enabled": true,
+		}
+		if rule.ExpirationDays > 0 {
+			ruleAttrs["expiration"] = map[string]interface{}{
+				"days": rule.ExpirationDays,
+			}
+		}
+		if rule.TransitionDays > 0 && rule.StorageClass != "" {
+			ruleAttrs["transitions"] = []map[string]interface{}{
+				{
+					"days":          rule.TransitionDays,
+					"storage_class": rule.StorageClass,
+				},
+			}
+		}
+		if rule.AbortIncompleteMultipartUploadDays > 0 {
+			ruleAttrs["abort_multipart_upload"] = map[string]interface{}{
+				"days": rule.AbortIncompleteMultipartUploadDays,
+			}
+		}
+		ossRules = append(ossRules, ruleAttrs)
+	}
+	return ossRules
+}
+
+// alicloudOSSCORSRules translates the provider-agnostic CORSRule configs into alicloud_oss_bucket
+// cors_rule blocks.
+func alicloudOSSCORSRules(rules []CORSRule) []map[string]interface{} {
+	ossRules := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		ruleAttrs := map[string]interface{}{
+			"allowed_origins": rule.AllowedOrigins,
+			"allowed_methods": rule.AllowedMethods,
+		}
+		if len(rule.AllowedHeaders) > 0 {
+			ruleAttrs["allowed_headers"] = rule.AllowedHeaders
+		}
+		if len(rule.ExposeHeaders) > 0 {
+			ruleAttrs["expose_headers"] = rule.ExposeHeaders
+		}
+		if rule.MaxAgeSeconds > 0 {
+			ruleAttrs["max_age_seconds"] = rule.MaxAgeSeconds
+		}
+		ossRules = append(ossRules, ruleAttrs)
+	}
+	return ossRules
+}
+
+// generateAlicloudOSSBucketPublicAccessBlock generates the alicloud_oss_bucket_public_access_block
+// resource blocking all forms of public access to the Alicloud provided ObjectStorage bucket.
+func (objectStorage *ObjectStorage) generateAlicloudOSSBucketPublicAccessBlock(alicloudProviderCfg module.ProviderConfig, ossBucketID string) (*apiv1.Resource, error) {
+	resAttrs := map[string]interface{}{
+		"bucket":            modules.KusionPathDependency(ossBucketID, "bucket"),
+		"block_public_acls": true,
+	}
+
+	id, err := module.TerraformResourceID(alicloudProviderCfg, alicloudOSSBucketPublicAccessBlock, objectStorage.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return module.WrapTFResourceToKusionResource(alicloudProviderCfg, alicloudOSSBucketPublicAccessBlock, id, resAttrs, nil)
+}
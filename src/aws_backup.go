@@ -0,0 +1,271 @@
+package main
+
+import (
+	"encoding/json"
+
+	"kusionstack.io/kusion-module-framework/pkg/module"
+	apiv1 "kusionstack.io/kusion/pkg/apis/api.kusion.io/v1"
+	"kusionstack.io/kusion/pkg/modules"
+)
+
+var (
+	awsS3BucketReplicationConfiguration = "aws_s3_bucket_replication_configuration"
+	awsBackupPlan                       = "aws_backup_plan"
+	awsBackupSelection                  = "aws_backup_selection"
+	awsBackupVault                      = "aws_backup_vault"
+)
+
+// generateAWSBucketBackup generates the cross-region replication and, when Backup.Schedule is set,
+// the scheduled snapshot resources for the bucket. Replication requires bucket versioning, which is
+// enabled here if Versioning was not already requested.
+func (objectStorage *ObjectStorage) generateAWSBucketBackup(awsProviderCfg module.ProviderConfig, awsS3BucketID string) ([]apiv1.Resource, error) {
+	var resources []apiv1.Resource
+
+	if !objectStorage.Versioning {
+		versioning, err := objectStorage.generateAWSS3BucketVersioning(awsProviderCfg, awsS3BucketID)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, *versioning)
+	}
+
+	destinationBucketArn, err := objectStorage.generateAWSBackupDestinationBucket(&resources, awsProviderCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	replicationRole, replicationRoleArn, err := objectStorage.generateAWSReplicationRole(awsProviderCfg, awsS3BucketID, destinationBucketArn)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, *replicationRole)
+
+	replication, err := objectStorage.generateAWSS3BucketReplication(awsProviderCfg, awsS3BucketID, destinationBucketArn, replicationRoleArn)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, *replication)
+
+	if objectStorage.Backup.Schedule != "" {
+		backupResources, err := objectStorage.generateAWSBackupPlan(awsProviderCfg, awsS3BucketID)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, backupResources...)
+	}
+
+	return resources, nil
+}
+
+// generateAWSBackupDestinationBucket returns the ARN of the replication destination bucket, creating
+// it in Backup.DestinationRegion when Backup.DestinationBucket does not reference an existing one.
+func (objectStorage *ObjectStorage) generateAWSBackupDestinationBucket(resources *[]apiv1.Resource, awsProviderCfg module.ProviderConfig) (string, error) {
+	if objectStorage.Backup.DestinationBucket != "" {
+		return "arn:aws:s3:::" + objectStorage.Backup.DestinationBucket, nil
+	}
+
+	destinationProviderCfg := awsProviderCfg
+	destinationProviderCfg.ProviderMeta = map[string]any{"region": objectStorage.Backup.DestinationRegion}
+
+	destinationBucketName := objectStorage.Bucket + "-replica"
+	resAttrs := map[string]interface{}{
+		"bucket": destinationBucketName,
+	}
+
+	id, err := module.TerraformResourceID(destinationProviderCfg, awsS3Bucket, destinationBucketName)
+	if err != nil {
+		return "", err
+	}
+
+	resource, err := module.WrapTFResourceToKusionResource(destinationProviderCfg, awsS3Bucket, id, resAttrs, nil)
+	if err != nil {
+		return "", err
+	}
+	*resources = append(*resources, *resource)
+
+	versioning, err := objectStorage.generateAWSS3BucketVersioningForBucket(awsProviderCfg, id, destinationBucketName)
+	if err != nil {
+		return "", err
+	}
+	*resources = append(*resources, *versioning)
+
+	return modules.KusionPathDependency(id, "arn"), nil
+}
+
+// generateAWSS3BucketVersioningForBucket generates the aws_s3_bucket_versioning resource for an
+// arbitrary bucket ID, used for the replication destination bucket.
+func (objectStorage *ObjectStorage) generateAWSS3BucketVersioningForBucket(awsProviderCfg module.ProviderConfig, bucketID, bucketName string) (*apiv1.Resource, error) {
+	resAttrs := map[string]interface{}{
+		"bucket": modules.KusionPathDependency(bucketID, "id"),
+		"versioning_configuration": map[string]interface{}{
+			"status": "Enabled",
+		},
+	}
+
+	id, err := module.TerraformResourceID(awsProviderCfg, awsS3BucketVersioning, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	return module.WrapTFResourceToKusionResource(awsProviderCfg, awsS3BucketVersioning, id, resAttrs, nil)
+}
+
+// generateAWSReplicationRole generates the aws_iam_role replication workers assume to read source
+// object versions and write them to the destination bucket.
+func (objectStorage *ObjectStorage) generateAWSReplicationRole(awsProviderCfg module.ProviderConfig, awsS3BucketID, destinationBucketArn string) (*apiv1.Resource, string, error) {
+	assumeRolePolicy, err := json.Marshal(map[string]interface{}{
+		"Version": "2012-10-17",
+		"Statement": []map[string]interface{}{
+			{
+				"Effect": "Allow",
+				"Principal": map[string]interface{}{
+					"Service": "s3.amazonaws.com",
+				},
+				"Action": "sts:AssumeRole",
+			},
+		},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	bucketArn := modules.KusionPathDependency(awsS3BucketID, "arn")
+
+	policyDocument, err := json.Marshal(map[string]interface{}{
+		"Version": "2012-10-17",
+		"Statement": []map[string]interface{}{
+			{
+				"Effect":   "Allow",
+				"Action":   []string{"s3:GetReplicationConfiguration", "s3:ListBucket"},
+				"Resource": bucketArn,
+			},
+			{
+				"Effect":   "Allow",
+				"Action":   []string{"s3:GetObjectVersionForReplication", "s3:GetObjectVersionAcl", "s3:GetObjectVersionTagging"},
+				"Resource": bucketArn + "/*",
+			},
+			{
+				"Effect":   "Allow",
+				"Action":   []string{"s3:ReplicateObject", "s3:ReplicateDelete", "s3:ReplicateTags"},
+				"Resource": destinationBucketArn + "/*",
+			},
+		},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	resAttrs := map[string]interface{}{
+		"name":               objectStorage.Bucket + "-replication-role",
+		"assume_role_policy": string(assumeRolePolicy),
+		"inline_policy": []map[string]interface{}{
+			{
+				"name":   objectStorage.Bucket + "-replication-policy",
+				"policy": string(policyDocument),
+			},
+		},
+	}
+
+	id, err := module.TerraformResourceID(awsProviderCfg, awsIAMRole, objectStorage.Bucket+"-replication")
+	if err != nil {
+		return nil, "", err
+	}
+
+	resource, err := module.WrapTFResourceToKusionResource(awsProviderCfg, awsIAMRole, id, resAttrs, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return resource, modules.KusionPathDependency(id, "arn"), nil
+}
+
+// generateAWSS3BucketReplication generates the aws_s3_bucket_replication_configuration resource
+// replicating the bucket's objects to the destination bucket.
+func (objectStorage *ObjectStorage) generateAWSS3BucketReplication(awsProviderCfg module.ProviderConfig, awsS3BucketID, destinationBucketArn, replicationRoleArn string) (*apiv1.Resource, error) {
+	resAttrs := map[string]interface{}{
+		"bucket": modules.KusionPathDependency(awsS3BucketID, "id"),
+		"role":   replicationRoleArn,
+		"rule": []map[string]interface{}{
+			{
+				"id":     "default",
+				"status": "Enabled",
+				"destination": map[string]interface{}{
+					"bucket": destinationBucketArn,
+				},
+			},
+		},
+	}
+
+	id, err := module.TerraformResourceID(awsProviderCfg, awsS3BucketReplicationConfiguration, objectStorage.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return module.WrapTFResourceToKusionResource(awsProviderCfg, awsS3BucketReplicationConfiguration, id, resAttrs, nil)
+}
+
+// generateAWSBackupPlan generates the aws_backup_vault, aws_backup_plan and aws_backup_selection
+// resources that take scheduled, retained snapshots of the bucket via AWS Backup.
+func (objectStorage *ObjectStorage) generateAWSBackupPlan(awsProviderCfg module.ProviderConfig, awsS3BucketID string) ([]apiv1.Resource, error) {
+	var resources []apiv1.Resource
+
+	vaultName := objectStorage.Bucket + "-backup-vault"
+	vaultAttrs := map[string]interface{}{
+		"name": vaultName,
+	}
+
+	vaultID, err := module.TerraformResourceID(awsProviderCfg, awsBackupVault, objectStorage.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	vault, err := module.WrapTFResourceToKusionResource(awsProviderCfg, awsBackupVault, vaultID, vaultAttrs, nil)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, *vault)
+
+	planAttrs := map[string]interface{}{
+		"name": objectStorage.Bucket + "-backup-plan",
+		"rule": []map[string]interface{}{
+			{
+				"rule_name":         objectStorage.Bucket + "-backup-rule",
+				"target_vault_name": modules.KusionPathDependency(vaultID, "name"),
+				"schedule":          objectStorage.Backup.Schedule,
+				"lifecycle": map[string]interface{}{
+					"delete_after": objectStorage.Backup.RetentionDays,
+				},
+			},
+		},
+	}
+
+	planID, err := module.TerraformResourceID(awsProviderCfg, awsBackupPlan, objectStorage.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := module.WrapTFResourceToKusionResource(awsProviderCfg, awsBackupPlan, planID, planAttrs, nil)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, *plan)
+
+	selectionAttrs := map[string]interface{}{
+		"name":      objectStorage.Bucket + "-backup-selection",
+		"plan_id":   modules.KusionPathDependency(planID, "id"),
+		"resources": []string{modules.KusionPathDependency(awsS3BucketID, "arn")},
+	}
+
+	selectionID, err := module.TerraformResourceID(awsProviderCfg, awsBackupSelection, objectStorage.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	selection, err := module.WrapTFResourceToKusionResource(awsProviderCfg, awsBackupSelection, selectionID, selectionAttrs, nil)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, *selection)
+
+	return resources, nil
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"kusionstack.io/kusion-module-framework/pkg/module"
+	apiv1 "kusionstack.io/kusion/pkg/apis/api.kusion.io/v1"
+	"kusionstack.io/kusion/pkg/modules"
+)
+
+var (
+	awsIAMPolicy = "aws_iam_policy"
+	awsIAMRole   = "aws_iam_role"
+)
+
+// awsAccessActions maps an Access.Mode to the S3 actions granted to the bucket.
+var awsAccessActions = map[string][]string{
+	"readwrite": {"s3:GetObject", "s3:PutObject", "s3:DeleteObject", "s3:ListBucket"},
+	"readonly":  {"s3:GetObject", "s3:ListBucket"},
+	"writeonly": {"s3:PutObject"},
+}
+
+// generateAWSBucketAccess generates the least-privilege aws_iam_policy and aws_iam_role resources
+// granting workloads access to the bucket, using an IRSA trust relationship when Access.UseIRSA is set.
+func (objectStorage *ObjectStorage) generateAWSBucketAccess(request *module.GeneratorRequest, awsProviderCfg module.ProviderConfig, awsS3BucketID string) ([]apiv1.Resource, error) {
+	var resources []apiv1.Resource
+
+	policy, policyArn, err := objectStorage.generateAWSIAMPolicy(awsProviderCfg, awsS3BucketID)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, *policy)
+
+	role, err := objectStorage.generateAWSIAMRole(request, awsProviderCfg, policyArn)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, *role)
+
+	return resources, nil
+}
+
+// generateAWSIAMPolicy generates the aws_iam_policy resource scoped to the actions permitted by
+// Access.Mode on the bucket and its objects.
+func (objectStorage *ObjectStorage) generateAWSIAMPolicy(awsProviderCfg module.ProviderConfig, awsS3BucketID string) (*apiv1.Resource, string, error) {
+	mode := strings.ToLower(objectStorage.Access.Mode)
+	if mode == "" {
+		mode = "readwrite"
+	}
+
+	bucketArn := modules.KusionPathDependency(awsS3BucketID, "arn")
+
+	document, err := json.Marshal(map[string]interface{}{
+		"Version": "2012-10-17",
+		"Statement": []map[string]interface{}{
+			{
+				"Effect":   "Allow",
+				"Action":   awsAccessActions[mode],
+				"Resource": []string{bucketArn, bucketArn + "/*"},
+			},
+		},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	resAttrs := map[string]interface{}{
+		"name":   objectStorage.Bucket + "-access",
+		"policy": string(document),
+	}
+
+	id, err := module.TerraformResourceID(awsProviderCfg, awsIAMPolicy, objectStorage.Bucket)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resource, err := module.WrapTFResourceToKusionResource(awsProviderCfg, awsIAMPolicy, id, resAttrs, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return resource, modules.KusionPathDependency(id, "arn"), nil
+}
+
+// generateAWSIAMRole generates the aws_iam_role resource that workloads assume to reach the bucket.
+// When Access.UseIRSA is enabled, the role's trust policy allows the Access.ServiceAccountName
+// Kubernetes ServiceAccount to assume it via the cluster's OIDC identity provider (IRSA); otherwise it
+// trusts the configured Access.Principals directly.
+func (objectStorage *ObjectStorage) generateAWSIAMRole(request *module.GeneratorRequest, awsProviderCfg module.ProviderConfig, policyArn string) (*apiv1.Resource, error) {
+	var statement map[string]interface{}
+	if objectStorage.Access.UseIRSA {
+		oidcProvider := objectStorage.Access.OIDCProvider
+		serviceAccount := request.Project + ":" + objectStorage.Access.ServiceAccountName
+		statement = map[string]interface{}{
+			"Effect": "Allow",
+			"Principal": map[string]interface{}{
+				"Federated": oidcProvider,
+			},
+			"Action": "sts:AssumeRoleWithWebIdentity",
+			"Condition": map[string]interface{}{
+				"StringEquals": map[string]interface{}{
+					oidcProvider + ":sub": "system:serviceaccount:" + serviceAccount,
+				},
+			},
+		}
+	} else {
+		statement = map[string]interface{}{
+			"Effect": "Allow",
+			"Principal": map[string]interface{}{
+				"AWS": objectStorage.Access.Principals,
+			},
+			"Action": "sts:AssumeRole",
+		}
+	}
+
+	assumeRolePolicy, err := json.Marshal(map[string]interface{}{
+		"Version":   "2012-10-17",
+		"Statement": []map[string]interface{}{statement},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resAttrs := map[string]interface{}{
+		"name":                objectStorage.Bucket + "-access-role",
+		"assume_role_policy":  string(assumeRolePolicy),
+		"managed_policy_arns": []string{policyArn},
+	}
+
+	id, err := module.TerraformResourceID(awsProviderCfg, awsIAMRole, objectStorage.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return module.WrapTFResourceToKusionResource(awsProviderCfg, awsIAMRole, id, resAttrs, nil)
+}
+
+// generateAWSAccessPatcher builds the Patcher that annotates the workload's ServiceAccount with the
+// IRSA role ARN and mounts the projected ServiceAccount token volume the AWS SDK needs to assume it.
+func (objectStorage *ObjectStorage) generateAWSAccessPatcher(awsProviderCfg module.ProviderConfig) (*apiv1.Patcher, error) {
+	if !objectStorage.Access.UseIRSA {
+		return nil, nil
+	}
+
+	roleID, err := module.TerraformResourceID(awsProviderCfg, awsIAMRole, objectStorage.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	roleArn := modules.KusionPathDependency(roleID, "arn")
+
+	volumeName := "aws-iam-token"
+	return &apiv1.Patcher{
+		Annotations: map[string]string{
+			"eks.amazonaws.com/role-arn": roleArn,
+		},
+		Volumes: []v1.Volume{
+			{
+				Name: volumeName,
+				VolumeSource: v1.VolumeSource{
+					Projected: &v1.ProjectedVolumeSource{
+						Sources: []v1.VolumeProjection{
+							{
+								ServiceAccountToken: &v1.ServiceAccountTokenProjection{
+									Audience:          "sts.amazonaws.com",
+									ExpirationSeconds: int64Ptr(86400),
+									Path:              "token",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		VolumeMounts: []v1.VolumeMount{
+			{
+				Name:      volumeName,
+				MountPath: "/var/run/secrets/eks.amazonaws.com/serviceaccount",
+				ReadOnly:  true,
+			},
+		},
+	}, nil
+}
+
+// int64Ptr returns a pointer to the given int64, used for Kubernetes fields that take *int64.
+func int64Ptr(i int64) *int64 {
+	return &i
+}
@@ -17,6 +17,13 @@ var (
 	awsSecurityGroup = "aws_security_group"
 	awsDBInstance    = "aws_db_instance"
 	awsS3Bucket      = "aws_s3_bucket"
+
+	awsS3BucketVersioning        = "aws_s3_bucket_versioning"
+	awsS3BucketEncryption        = "aws_s3_bucket_server_side_encryption_configuration"
+	awsS3BucketLifecycle         = "aws_s3_bucket_lifecycle_configuration"
+	awsS3BucketPublicAccessBlock = "aws_s3_bucket_public_access_block"
+	awsS3BucketCORS              = "aws_s3_bucket_cors_configuration"
+	awsS3BucketLogging           = "aws_s3_bucket_logging"
 )
 
 var defaultAWSProviderCfg = module.ProviderConfig{
@@ -58,6 +65,76 @@ func (objectStorage *ObjectStorage) GenerateAWSResources(request *module.Generat
 	}
 	resources = append(resources, *awsS3Bucket)
 
+	if objectStorage.Versioning {
+		versioning, err := objectStorage.generateAWSS3BucketVersioning(awsProviderCfg, awsS3BucketID)
+		if err != nil {
+			return nil, nil, err
+		}
+		resources = append(resources, *versioning)
+	}
+
+	if objectStorage.Encryption != nil {
+		encryption, err := objectStorage.generateAWSS3BucketEncryption(awsProviderCfg, awsS3BucketID)
+		if err != nil {
+			return nil, nil, err
+		}
+		resources = append(resources, *encryption)
+	}
+
+	if len(objectStorage.Lifecycle) > 0 {
+		lifecycle, err := objectStorage.generateAWSS3BucketLifecycle(awsProviderCfg, awsS3BucketID)
+		if err != nil {
+			return nil, nil, err
+		}
+		resources = append(resources, *lifecycle)
+	}
+
+	if objectStorage.PublicAccessBlock {
+		publicAccessBlock, err := objectStorage.generateAWSS3BucketPublicAccessBlock(awsProviderCfg, awsS3BucketID)
+		if err != nil {
+			return nil, nil, err
+		}
+		resources = append(resources, *publicAccessBlock)
+	}
+
+	if len(objectStorage.CORS) > 0 {
+		cors, err := objectStorage.generateAWSS3BucketCORS(awsProviderCfg, awsS3BucketID)
+		if err != nil {
+			return nil, nil, err
+		}
+		resources = append(resources, *cors)
+	}
+
+	if objectStorage.Logging != nil {
+		logging, err := objectStorage.generateAWSS3BucketLogging(awsProviderCfg, awsS3BucketID)
+		if err != nil {
+			return nil, nil, err
+		}
+		resources = append(resources, *logging)
+	}
+
+	if objectStorage.Backup != nil {
+		backupResources, err := objectStorage.generateAWSBucketBackup(awsProviderCfg, awsS3BucketID)
+		if err != nil {
+			return nil, nil, err
+		}
+		resources = append(resources, backupResources...)
+	}
+
+	var accessPatcher *apiv1.Patcher
+	if objectStorage.Access != nil {
+		accessResources, err := objectStorage.generateAWSBucketAccess(request, awsProviderCfg, awsS3BucketID)
+		if err != nil {
+			return nil, nil, err
+		}
+		resources = append(resources, accessResources...)
+
+		accessPatcher, err = objectStorage.generateAWSAccessPatcher(awsProviderCfg)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	bucketDomainName := modules.KusionPathDependency(awsS3BucketID, "bucket_domain_name")
 	bucketRegionalDomainName := modules.KusionPathDependency(awsS3BucketID, "bucket_regional_domain_name")
 
@@ -77,6 +154,12 @@ func (objectStorage *ObjectStorage) GenerateAWSResources(request *module.Generat
 		Environments: envVars,
 	}
 
+	if accessPatcher != nil {
+		patcher.Annotations = accessPatcher.Annotations
+		patcher.Volumes = accessPatcher.Volumes
+		patcher.VolumeMounts = accessPatcher.VolumeMounts
+	}
+
 	// hostAddress := modules.KusionPathDependency(awsDBInstanceID, "address")
 	// password := modules.KusionPathDependency(randomPasswordID, "result")
 
@@ -96,6 +179,9 @@ func (objectStorage *ObjectStorage) generateAWSS3Bucket(awsProviderCfg module.Pr
 	resAttrs := map[string]interface{}{
 		"bucket": objectStorage.Bucket,
 	}
+	if len(objectStorage.Tags) > 0 {
+		resAttrs["tags"] = objectStorage.Tags
+	}
 
 	id, err := module.TerraformResourceID(awsProviderCfg, awsS3Bucket, objectStorage.Bucket)
 	if err != nil {
@@ -110,3 +196,169 @@ func (objectStorage *ObjectStorage) generateAWSS3Bucket(awsProviderCfg module.Pr
 
 	return resource, id, nil
 }
+
+// generateAWSS3BucketVersioning generates the aws_s3_bucket_versioning resource enabling bucket
+// versioning for the AWS provided ObjectStorage bucket.
+func (objectStorage *ObjectStorage) generateAWSS3BucketVersioning(awsProviderCfg module.ProviderConfig, awsS3BucketID string) (*apiv1.Resource, error) {
+	resAttrs := map[string]interface{}{
+		"bucket": modules.KusionPathDependency(awsS3BucketID, "id"),
+		"versioning_configuration": map[string]interface{}{
+			"status": "Enabled",
+		},
+	}
+
+	id, err := module.TerraformResourceID(awsProviderCfg, awsS3BucketVersioning, objectStorage.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return module.WrapTFResourceToKusionResource(awsProviderCfg, awsS3BucketVersioning, id, resAttrs, nil)
+}
+
+// generateAWSS3BucketEncryption generates the aws_s3_bucket_server_side_encryption_configuration
+// resource for the AWS provided ObjectStorage bucket.
+func (objectStorage *ObjectStorage) generateAWSS3BucketEncryption(awsProviderCfg module.ProviderConfig, awsS3BucketID string) (*apiv1.Resource, error) {
+	sseAlgorithm := objectStorage.Encryption.SSEAlgorithm
+	if sseAlgorithm == "" {
+		sseAlgorithm = "AES256"
+	}
+
+	applyServerSideEncryptionByDefault := map[string]interface{}{
+		"sse_algorithm": sseAlgorithm,
+	}
+	if objectStorage.Encryption.KMSKeyID != "" {
+		applyServerSideEncryptionByDefault["kms_master_key_id"] = objectStorage.Encryption.KMSKeyID
+	}
+
+	resAttrs := map[string]interface{}{
+		"bucket": modules.KusionPathDependency(awsS3BucketID, "id"),
+		"rule": []map[string]interface{}{
+			{
+				"apply_server_side_encryption_by_default": applyServerSideEncryptionByDefault,
+			},
+		},
+	}
+
+	id, err := module.TerraformResourceID(awsProviderCfg, awsS3BucketEncryption, objectStorage.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return module.WrapTFResourceToKusionResource(awsProviderCfg, awsS3BucketEncryption, id, resAttrs, nil)
+}
+
+// generateAWSS3BucketLifecycle generates the aws_s3_bucket_lifecycle_configuration resource for the
+// AWS provided ObjectStorage bucket.
+func (objectStorage *ObjectStorage) generateAWSS3BucketLifecycle(awsProviderCfg module.ProviderConfig, awsS3BucketID string) (*apiv1.Resource, error) {
+	rules := make([]map[string]interface{}, 0, len(objectStorage.Lifecycle))
+	for _, rule := range objectStorage.Lifecycle {
+		ruleAttrs := map[string]interface{}{
+			"id":     rule.ID,
+			"status": "Enabled",
+			"filter": map[string]interface{}{
+				"prefix": rule.Prefix,
+			},
+		}
+		if rule.ExpirationDays > 0 {
+			ruleAttrs["expiration"] = map[string]interface{}{
+				"days": rule.ExpirationDays,
+			}
+		}
+		if rule.TransitionDays > 0 && rule.StorageClass != "" {
+			ruleAttrs["transition"] = []map[string]interface{}{
+				{
+					"days":          rule.TransitionDays,
+					"storage_class": rule.StorageClass,
+				},
+			}
+		}
+		if rule.AbortIncompleteMultipartUploadDays > 0 {
+			ruleAttrs["abort_incomplete_multipart_upload"] = map[string]interface{}{
+				"days_after_initiation": rule.AbortIncompleteMultipartUploadDays,
+			}
+		}
+		rules = append(rules, ruleAttrs)
+	}
+
+	resAttrs := map[string]interface{}{
+		"bucket": modules.KusionPathDependency(awsS3BucketID, "id"),
+		"rule":   rules,
+	}
+
+	id, err := module.TerraformResourceID(awsProviderCfg, awsS3BucketLifecycle, objectStorage.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return module.WrapTFResourceToKusionResource(awsProviderCfg, awsS3BucketLifecycle, id, resAttrs, nil)
+}
+
+// generateAWSS3BucketPublicAccessBlock generates the aws_s3_bucket_public_access_block resource
+// blocking all forms of public access to the AWS provided ObjectStorage bucket.
+func (objectStorage *ObjectStorage) generateAWSS3BucketPublicAccessBlock(awsProviderCfg module.ProviderConfig, awsS3BucketID string) (*apiv1.Resource, error) {
+	resAttrs := map[string]interface{}{
+		"bucket":                  modules.KusionPathDependency(awsS3BucketID, "id"),
+		"block_public_acls":       true,
+		"block_public_policy":     true,
+		"ignore_public_acls":      true,
+		"restrict_public_buckets": true,
+	}
+
+	id, err := module.TerraformResourceID(awsProviderCfg, awsS3BucketPublicAccessBlock, objectStorage.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return module.WrapTFResourceToKusionResource(awsProviderCfg, awsS3BucketPublicAccessBlock, id, resAttrs, nil)
+}
+
+// generateAWSS3BucketCORS generates the aws_s3_bucket_cors_configuration resource for the AWS provided
+// ObjectStorage bucket.
+func (objectStorage *ObjectStorage) generateAWSS3BucketCORS(awsProviderCfg module.ProviderConfig, awsS3BucketID string) (*apiv1.Resource, error) {
+	rules := make([]map[string]interface{}, 0, len(objectStorage.CORS))
+	for _, rule := range objectStorage.CORS {
+		ruleAttrs := map[string]interface{}{
+			"allowed_origins": rule.AllowedOrigins,
+			"allowed_methods": rule.AllowedMethods,
+		}
+		if len(rule.AllowedHeaders) > 0 {
+			ruleAttrs["allowed_headers"] = rule.AllowedHeaders
+		}
+		if len(rule.ExposeHeaders) > 0 {
+			ruleAttrs["expose_headers"] = rule.ExposeHeaders
+		}
+		if rule.MaxAgeSeconds > 0 {
+			ruleAttrs["max_age_seconds"] = rule.MaxAgeSeconds
+		}
+		rules = append(rules, ruleAttrs)
+	}
+
+	resAttrs := map[string]interface{}{
+		"bucket":    modules.KusionPathDependency(awsS3BucketID, "id"),
+		"cors_rule": rules,
+	}
+
+	id, err := module.TerraformResourceID(awsProviderCfg, awsS3BucketCORS, objectStorage.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return module.WrapTFResourceToKusionResource(awsProviderCfg, awsS3BucketCORS, id, resAttrs, nil)
+}
+
+// generateAWSS3BucketLogging generates the aws_s3_bucket_logging resource for the AWS provided
+// ObjectStorage bucket.
+func (objectStorage *ObjectStorage) generateAWSS3BucketLogging(awsProviderCfg module.ProviderConfig, awsS3BucketID string) (*apiv1.Resource, error) {
+	resAttrs := map[string]interface{}{
+		"bucket":        modules.KusionPathDependency(awsS3BucketID, "id"),
+		"target_bucket": objectStorage.Logging.TargetBucket,
+		"target_prefix": objectStorage.Logging.TargetPrefix,
+	}
+
+	id, err := module.TerraformResourceID(awsProviderCfg, awsS3BucketLogging, objectStorage.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return module.WrapTFResourceToKusionResource(awsProviderCfg, awsS3BucketLogging, id, resAttrs, nil)
+}
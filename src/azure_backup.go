@@ -0,0 +1,111 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"kusionstack.io/kusion-module-framework/pkg/module"
+	apiv1 "kusionstack.io/kusion/pkg/apis/api.kusion.io/v1"
+	"kusionstack.io/kusion/pkg/modules"
+)
+
+var ErrEmptyAzureSubscriptionID = errors.New("azure subscription id is required to reference an existing backup destination storage account")
+
+var (
+	azureSubscriptionEnv          = "AZURE_SUBSCRIPTION_ID"
+	azureStorageObjectReplication = "azurerm_storage_object_replication"
+)
+
+// generateAzureBucketBackup generates the cross-region object replication resources for the storage
+// container. Replication requires source blob versioning and change feed, which
+// generateAzureStorageAccount enables on the source account when Backup is set, and a destination
+// storage account/container in
+// Backup.DestinationRegion. On Azure, Backup.DestinationBucket identifies an existing *storage
+// account* name rather than a container - the destination container reuses the source container name,
+// mirroring the existing replication rule this account already serves.
+func (objectStorage *ObjectStorage) generateAzureBucketBackup(azureProviderCfg module.ProviderConfig, resourceGroup, storageAccountID string) ([]apiv1.Resource, error) {
+	var resources []apiv1.Resource
+
+	destinationContainerName := objectStorage.Bucket
+	var destinationAccountID string
+
+	if objectStorage.Backup.DestinationBucket != "" {
+		subscriptionID := os.Getenv(azureSubscriptionEnv)
+		if subscriptionID == "" {
+			return nil, ErrEmptyAzureSubscriptionID
+		}
+
+		destinationAccountID = fmt.Sprintf(
+			"/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s",
+			subscriptionID, resourceGroup, objectStorage.Backup.DestinationBucket,
+		)
+	} else {
+		destinationAccountName := objectStorage.Bucket + "replica"
+		destinationAccountAttrs := map[string]interface{}{
+			"name":                     destinationAccountName,
+			"resource_group_name":      resourceGroup,
+			"location":                 objectStorage.Backup.DestinationRegion,
+			"account_tier":             "Standard",
+			"account_replication_type": "LRS",
+			"blob_properties": map[string]interface{}{
+				"versioning_enabled":  true,
+				"change_feed_enabled": true,
+			},
+		}
+
+		var err error
+		destinationAccountID, err = module.TerraformResourceID(azureProviderCfg, azureStorageAccount, destinationAccountName)
+		if err != nil {
+			return nil, err
+		}
+
+		destinationAccountProviderCfg := azureProviderCfg
+		destinationAccountProviderCfg.ProviderMeta = map[string]any{"features": map[string]any{}}
+		destinationAccount, err := module.WrapTFResourceToKusionResource(destinationAccountProviderCfg, azureStorageAccount, destinationAccountID, destinationAccountAttrs, nil)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, *destinationAccount)
+
+		destinationContainerAttrs := map[string]interface{}{
+			"name":                 destinationContainerName,
+			"storage_account_name": modules.KusionPathDependency(destinationAccountID, "name"),
+		}
+		destinationContainerID, err := module.TerraformResourceID(azureProviderCfg, azureStorageContainer, destinationAccountName)
+		if err != nil {
+			return nil, err
+		}
+		destinationContainer, err := module.WrapTFResourceToKusionResource(azureProviderCfg, azureStorageContainer, destinationContainerID, destinationContainerAttrs, nil)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, *destinationContainer)
+
+		destinationAccountID = modules.KusionPathDependency(destinationAccountID, "id")
+	}
+
+	replicationAttrs := map[string]interface{}{
+		"source_storage_account_id":      modules.KusionPathDependency(storageAccountID, "id"),
+		"destination_storage_account_id": destinationAccountID,
+		"rules": []map[string]interface{}{
+			{
+				"source_container_name":      objectStorage.Bucket,
+				"destination_container_name": destinationContainerName,
+			},
+		},
+	}
+
+	id, err := module.TerraformResourceID(azureProviderCfg, azureStorageObjectReplication, objectStorage.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	replication, err := module.WrapTFResourceToKusionResource(azureProviderCfg, azureStorageObjectReplication, id, replicationAttrs, nil)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, *replication)
+
+	return resources, nil
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"kusionstack.io/kusion-module-framework/pkg/module"
+	apiv1 "kusionstack.io/kusion/pkg/apis/api.kusion.io/v1"
+	"kusionstack.io/kusion/pkg/modules"
+)
+
+var (
+	ErrEmptyAzureProviderLocation         = errors.New("empty azure provider location")
+	ErrEmptyAzureResourceGroupName        = errors.New("empty azure resource group name")
+	ErrAzureCustomerManagedKeyUnsupported = errors.New("azure storage account encryption with a customer managed key is not supported")
+	ErrAzureLoggingUnsupported            = errors.New("azure storage account does not support logging to a target bucket")
+)
+
+var (
+	azureLocationEnv             = "AZURE_LOCATION"
+	azureResourceGroupEnv        = "AZURE_RESOURCE_GROUP"
+	azureStorageAccount          = "azurerm_storage_account"
+	azureStorageContainer        = "azurerm_storage_container"
+	azureStorageManagementPolicy = "azurerm_storage_management_policy"
+)
+
+var defaultAzureProviderCfg = module.ProviderConfig{
+	Source:  "hashicorp/azurerm",
+	Version: "3.107.0",
+}
+
+// GenerateAzureResources generates the Azure provided ObjectStorage blob container instance.
+func (objectStorage *ObjectStorage) GenerateAzureResources(request *module.GeneratorRequest) ([]apiv1.Resource, *apiv1.Patcher, error) {
+	var resources []apiv1.Resource
+
+	// Set the Azure provider with the default provider config.
+	azureProviderCfg := defaultAzureProviderCfg
+
+	// Get the Azure Terraform provider location, which should not be empty.
+	var location string
+	if location = module.TerraformProviderRegion(azureProviderCfg); location == "" {
+		location = os.Getenv(azureLocationEnv)
+	}
+	if location == "" {
+		return nil, nil, ErrEmptyAzureProviderLocation
+	}
+
+	resourceGroup := os.Getenv(azureResourceGroupEnv)
+	if resourceGroup == "" {
+		return nil, nil, ErrEmptyAzureResourceGroupName
+	}
+
+	if objectStorage.Encryption != nil && objectStorage.Encryption.KMSKeyID != "" {
+		return nil, nil, ErrAzureCustomerManagedKeyUnsupported
+	}
+	if objectStorage.Logging != nil {
+		return nil, nil, ErrAzureLoggingUnsupported
+	}
+
+	if objectStorage.Backup != nil {
+		objectStorage.Versioning = true
+	}
+
+	storageAccount, storageAccountID, err := objectStorage.generateAzureStorageAccount(azureProviderCfg, location, resourceGroup)
+	if err != nil {
+		return nil, nil, err
+	}
+	resources = append(resources, *storageAccount)
+
+	storageContainer, err := objectStorage.generateAzureStorageContainer(azureProviderCfg, storageAccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	resources = append(resources, *storageContainer)
+
+	if len(objectStorage.Lifecycle) > 0 {
+		managementPolicy, err := objectStorage.generateAzureStorageManagementPolicy(azureProviderCfg, storageAccountID)
+		if err != nil {
+			return nil, nil, err
+		}
+		resources = append(resources, *managementPolicy)
+	}
+
+	if objectStorage.Backup != nil {
+		backupResources, err := objectStorage.generateAzureBucketBackup(azureProviderCfg, resourceGroup, storageAccountID)
+		if err != nil {
+			return nil, nil, err
+		}
+		resources = append(resources, backupResources...)
+	}
+
+	blobEndpoint := modules.KusionPathDependency(storageAccountID, "primary_blob_endpoint")
+
+	envVars := []v1.EnvVar{
+		{
+			Name:  "KUSION_AZURE_BLOB_ENDPOINT",
+			Value: blobEndpoint,
+		},
+	}
+	patcher := &apiv1.Patcher{
+		Environments: envVars,
+	}
+
+	if objectStorage.Access != nil {
+		accessResources, identityClientID, err := objectStorage.generateAzureBucketAccess(request, azureProviderCfg, resourceGroup, location, storageAccountID)
+		if err != nil {
+			return nil, nil, err
+		}
+		resources = append(resources, accessResources...)
+
+		if objectStorage.Access.UseIRSA {
+			patcher.Annotations = map[string]string{
+				"azure.workload.identity/client-id": identityClientID,
+			}
+		}
+	}
+
+	return resources, patcher, nil
+}
+
+// generateAzureStorageAccount generates azurerm_storage_account resource for the Azure provided ObjectStorage instance.
+func (objectStorage *ObjectStorage) generateAzureStorageAccount(azureProviderCfg module.ProviderConfig, location, resourceGroup string) (*apiv1.Resource, string, error) {
+	resAttrs := map[string]interface{}{
+		"name":                     objectStorage.Bucket,
+		"resource_group_name":      resourceGroup,
+		"location":                 location,
+		"account_tier":             "Standard",
+		"account_replication_type": "LRS",
+	}
+	if len(objectStorage.Tags) > 0 {
+		resAttrs["tags"] = objectStorage.Tags
+	}
+	if objectStorage.PublicAccessBlock {
+		resAttrs["allow_nested_items_to_be_public"] = false
+	}
+	if objectStorage.Encryption != nil {
+		resAttrs["infrastructure_encryption_enabled"] = true
+	}
+
+	blobProperties := map[string]interface{}{}
+	if objectStorage.Versioning {
+		blobProperties["versioning_enabled"] = true
+	}
+	if objectStorage.Backup != nil {
+		blobProperties["change_feed_enabled"] = true
+	}
+	if len(objectStorage.CORS) > 0 {
+		blobProperties["cors_rule"] = azureBlobCORSRules(objectStorage.CORS)
+	}
+	if len(blobProperties) > 0 {
+		resAttrs["blob_properties"] = blobProperties
+	}
+
+	id, err := module.TerraformResourceID(azureProviderCfg, azureStorageAccount, objectStorage.Bucket)
+	if err != nil {
+		return nil, "", err
+	}
+
+	azureProviderCfg.ProviderMeta = map[string]any{"features": map[string]any{}}
+	resource, err := module.WrapTFResourceToKusionResource(azureProviderCfg, azureStorageAccount, id, resAttrs, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return resource, id, nil
+}
+
+// azureBlobCORSRules translates the provider-agnostic CORSRule configs into azurerm_storage_account
+// blob_properties.cors_rule blocks.
+func azureBlobCORSRules(rules []CORSRule) []map[string]interface{} {
+	azureRules := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		ruleAttrs := map[string]interface{}{
+			"allowed_origins":    rule.AllowedOrigins,
+			"allowed_methods":    rule.AllowedMethods,
+			"allowed_headers":    rule.AllowedHeaders,
+			"exposed_headers":    rule.ExposeHeaders,
+			"max_age_in_seconds": rule.MaxAgeSeconds,
+		}
+		azureRules = append(azureRules, ruleAttrs)
+	}
+	return azureRules
+}
+
+// generateAzureStorageManagementPolicy generates the azurerm_storage_management_policy resource
+// implementing the bucket's lifecycle rules for the Azure provided ObjectStorage instance.
+func (objectStorage *ObjectStorage) generateAzureStorageManagementPolicy(azureProviderCfg module.ProviderConfig, storageAccountID string) (*apiv1.Resource, error) {
+	rules := make([]map[string]interface{}, 0, len(objectStorage.Lifecycle))
+	for _, rule := range objectStorage.Lifecycle {
+		baseBlob := map[string]interface{}{}
+		if rule.ExpirationDays > 0 {
+			baseBlob["delete_after_days_since_modification_greater_than"] = rule.ExpirationDays
+		}
+		if rule.TransitionDays > 0 && rule.StorageClass != "" {
+			baseBlob["tier_to_"+strings.ToLower(rule.StorageClass)+"_after_days_since_modification_greater_than"] = rule.TransitionDays
+		}
+		ruleAttrs := map[string]interface{}{
+			"name":    rule.ID,
+			"enabled": true,
+			"filters": map[string]interface{}{
+				"prefix_match": []string{rule.Prefix},
+				"blob_types":   []string{"blockBlob"},
+			},
+			"actions": map[string]interface{}{
+				"base_blob": baseBlob,
+			},
+		}
+		rules = append(rules, ruleAttrs)
+	}
+
+	resAttrs := map[string]interface{}{
+		"storage_account_id": modules.KusionPathDependency(storageAccountID, "id"),
+		"rule":               rules,
+	}
+
+	id, err := module.TerraformResourceID(azureProviderCfg, azureStorageManagementPolicy, objectStorage.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return module.WrapTFResourceToKusionResource(azureProviderCfg, azureStorageManagementPolicy, id, resAttrs, nil)
+}
+
+// generateAzureStorageContainer generates azurerm_storage_container resource for the Azure provided ObjectStorage instance.
+func (objectStorage *ObjectStorage) generateAzureStorageContainer(azureProviderCfg module.ProviderConfig, storageAccountID string) (*apiv1.Resource, error) {
+	resAttrs := map[string]interface{}{
+		"name":                 objectStorage.Bucket,
+		"storage_account_name": modules.KusionPathDependency(storageAccountID, "name"),
+	}
+
+	id, err := module.TerraformResourceID(azureProviderCfg, azureStorageContainer, objectStorage.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := module.WrapTFResourceToKusionResource(azureProviderCfg, azureStorageContainer, id, resAttrs, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
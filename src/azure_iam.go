@@ -0,0 +1,113 @@
+package main
+
+import (
+	"strings"
+
+	"kusionstack.io/kusion-module-framework/pkg/module"
+	apiv1 "kusionstack.io/kusion/pkg/apis/api.kusion.io/v1"
+	"kusionstack.io/kusion/pkg/modules"
+)
+
+var (
+	azureUserAssignedIdentity        = "azurerm_user_assigned_identity"
+	azureFederatedIdentityCredential = "azurerm_federated_identity_credential"
+	azureRoleAssignment              = "azurerm_role_assignment"
+)
+
+// azureAccessRoles maps an Access.Mode to the built-in storage RBAC role granted on the storage
+// account. Azure has no built-in write-only blob role, so writeonly is granted the same role as
+// readwrite.
+var azureAccessRoles = map[string]string{
+	"readwrite": "Storage Blob Data Contributor",
+	"readonly":  "Storage Blob Data Reader",
+	"writeonly": "Storage Blob Data Contributor",
+}
+
+// generateAzureBucketAccess generates the azurerm_role_assignment resources granting the
+// Access.Mode-scoped role on the storage account. When Access.UseIRSA is set, it also generates a
+// azurerm_user_assigned_identity and azurerm_federated_identity_credential trusting
+// Access.ServiceAccountName via AKS workload identity federation, granting the role to that managed
+// identity instead of the configured Access.Principals, and returns its client ID for the workload's
+// ServiceAccount annotation.
+func (objectStorage *ObjectStorage) generateAzureBucketAccess(request *module.GeneratorRequest, azureProviderCfg module.ProviderConfig, resourceGroup, location, storageAccountID string) ([]apiv1.Resource, string, error) {
+	mode := strings.ToLower(objectStorage.Access.Mode)
+	if mode == "" {
+		mode = "readwrite"
+	}
+
+	var resources []apiv1.Resource
+	var principals []string
+	var clientID string
+
+	if objectStorage.Access.UseIRSA {
+		identityName := objectStorage.Bucket + "-access-identity"
+		identityAttrs := map[string]interface{}{
+			"name":                identityName,
+			"resource_group_name": resourceGroup,
+			"location":            location,
+		}
+
+		identityID, err := module.TerraformResourceID(azureProviderCfg, azureUserAssignedIdentity, objectStorage.Bucket)
+		if err != nil {
+			return nil, "", err
+		}
+
+		identity, err := module.WrapTFResourceToKusionResource(azureProviderCfg, azureUserAssignedIdentity, identityID, identityAttrs, nil)
+		if err != nil {
+			return nil, "", err
+		}
+		resources = append(resources, *identity)
+
+		federatedAttrs := map[string]interface{}{
+			"name":                identityName + "-federated",
+			"resource_group_name": resourceGroup,
+			"parent_id":           modules.KusionPathDependency(identityID, "id"),
+			"audience":            []string{"api://AzureADTokenExchange"},
+			"issuer":              objectStorage.Access.OIDCProvider,
+			"subject":             "system:serviceaccount:" + request.Project + ":" + objectStorage.Access.ServiceAccountName,
+		}
+
+		federatedID, err := module.TerraformResourceID(azureProviderCfg, azureFederatedIdentityCredential, objectStorage.Bucket)
+		if err != nil {
+			return nil, "", err
+		}
+
+		federated, err := module.WrapTFResourceToKusionResource(azureProviderCfg, azureFederatedIdentityCredential, federatedID, federatedAttrs, nil)
+		if err != nil {
+			return nil, "", err
+		}
+		resources = append(resources, *federated)
+
+		principals = []string{modules.KusionPathDependency(identityID, "principal_id")}
+		clientID = modules.KusionPathDependency(identityID, "client_id")
+	} else {
+		principals = objectStorage.Access.Principals
+	}
+
+	for i, principalID := range principals {
+		roleAssignmentAttrs := map[string]interface{}{
+			"scope":                modules.KusionPathDependency(storageAccountID, "id"),
+			"role_definition_name": azureAccessRoles[mode],
+			"principal_id":         principalID,
+		}
+
+		roleAssignmentID, err := module.TerraformResourceID(azureProviderCfg, azureRoleAssignment, objectStorage.Bucket+"-"+roleAssignmentSuffix(i))
+		if err != nil {
+			return nil, "", err
+		}
+
+		roleAssignment, err := module.WrapTFResourceToKusionResource(azureProviderCfg, azureRoleAssignment, roleAssignmentID, roleAssignmentAttrs, nil)
+		if err != nil {
+			return nil, "", err
+		}
+		resources = append(resources, *roleAssignment)
+	}
+
+	return resources, clientID, nil
+}
+
+// roleAssignmentSuffix disambiguates the Terraform resource key for one of several
+// azurerm_role_assignment resources generated for the same bucket's Access.Principals.
+func roleAssignmentSuffix(i int) string {
+	return "access-" + string(rune('a'+i))
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"kusionstack.io/kusion-module-framework/pkg/module"
+	apiv1 "kusionstack.io/kusion/pkg/apis/api.kusion.io/v1"
+	"kusionstack.io/kusion/pkg/modules"
+)
+
+var googleStorageTransferJob = "google_storage_transfer_job"
+
+// generateGCPBucketBackup generates the destination bucket and google_storage_transfer_job resources
+// that replicate the bucket's objects cross-region. Object versioning on the source bucket is turned on
+// by GenerateGCPResources before calling this, and a destination bucket created here has versioning
+// enabled.
+func (objectStorage *ObjectStorage) generateGCPBucketBackup(gcpProviderCfg module.ProviderConfig, project, gcsBucketID string) ([]apiv1.Resource, error) {
+	var resources []apiv1.Resource
+
+	destinationBucketName := objectStorage.Backup.DestinationBucket
+	if destinationBucketName == "" {
+		destinationBucketName = objectStorage.Bucket + "-replica"
+
+		destinationProviderCfg := gcpProviderCfg
+		destinationProviderCfg.ProviderMeta = map[string]any{"project": project, "region": objectStorage.Backup.DestinationRegion}
+
+		resAttrs := map[string]interface{}{
+			"name":     destinationBucketName,
+			"location": objectStorage.Backup.DestinationRegion,
+			"project":  project,
+			"versioning": map[string]interface{}{
+				"enabled": true,
+			},
+		}
+
+		id, err := module.TerraformResourceID(destinationProviderCfg, googleStorageBucket, destinationBucketName)
+		if err != nil {
+			return nil, err
+		}
+
+		destinationBucket, err := module.WrapTFResourceToKusionResource(destinationProviderCfg, googleStorageBucket, id, resAttrs, nil)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, *destinationBucket)
+	}
+
+	transferJobAttrs := map[string]interface{}{
+		"description": objectStorage.Bucket + "-backup-transfer",
+		"project":     project,
+		"transfer_spec": map[string]interface{}{
+			"gcs_data_source": map[string]interface{}{
+				"bucket_name": modules.KusionPathDependency(gcsBucketID, "name"),
+			},
+			"gcs_data_sink": map[string]interface{}{
+				"bucket_name": destinationBucketName,
+			},
+		},
+	}
+
+	id, err := module.TerraformResourceID(gcpProviderCfg, googleStorageTransferJob, objectStorage.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	transferJob, err := module.WrapTFResourceToKusionResource(gcpProviderCfg, googleStorageTransferJob, id, transferJobAttrs, nil)
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, *transferJob)
+
+	return resources, nil
+}
@@ -0,0 +1,301 @@
+package main
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"kusionstack.io/kusion-module-framework/pkg/module"
+	apiv1 "kusionstack.io/kusion/pkg/apis/api.kusion.io/v1"
+	"kusionstack.io/kusion/pkg/modules"
+)
+
+var (
+	localMinIOImage     = "minio/minio:RELEASE.2024-06-13T22-53-53Z"
+	localMinIOMCImage   = "minio/mc:RELEASE.2024-06-13T22-04-34Z"
+	localMinIOPort      = int32(9000)
+	localRandomPassword = "random_password"
+)
+
+var defaultRandomProviderCfg = module.ProviderConfig{
+	Source:  "hashicorp/random",
+	Version: "3.6.1",
+}
+
+// GenerateLocalResources generates an in-cluster MinIO deployment as an S3-compatible ObjectStorage
+// bucket, so that application workloads can be developed and tested without a real cloud account.
+func (objectStorage *ObjectStorage) GenerateLocalResources(request *module.GeneratorRequest) ([]apiv1.Resource, *apiv1.Patcher, error) {
+	var resources []apiv1.Resource
+
+	accessKeyID := "kusion"
+	randomPassword, randomPasswordID, err := objectStorage.generateLocalRandomPassword()
+	if err != nil {
+		return nil, nil, err
+	}
+	resources = append(resources, *randomPassword)
+	secretAccessKey := modules.KusionPathDependency(randomPasswordID, "result")
+
+	credentialSecretResources, credentialSecretName, err := objectStorage.generateCredentialSecret(request, objectStorage.Bucket+"-minio-credential", map[string]string{
+		"accessKeyID":     accessKeyID,
+		"secretAccessKey": secretAccessKey,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	resources = append(resources, credentialSecretResources...)
+
+	pvc, pvcName, err := objectStorage.generateLocalMinIOPVC(request)
+	if err != nil {
+		return nil, nil, err
+	}
+	resources = append(resources, *pvc)
+
+	deployment, err := objectStorage.generateLocalMinIODeployment(request, credentialSecretName, pvcName)
+	if err != nil {
+		return nil, nil, err
+	}
+	resources = append(resources, *deployment)
+
+	service, serviceName, err := objectStorage.generateLocalMinIOService(request)
+	if err != nil {
+		return nil, nil, err
+	}
+	resources = append(resources, *service)
+
+	endpoint := fmt.Sprintf("http://%s.%s:%d", serviceName, request.Project, localMinIOPort)
+
+	bootstrapJob, err := objectStorage.generateLocalBucketBootstrapJob(request, credentialSecretName, endpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	resources = append(resources, *bootstrapJob)
+
+	envVars := []v1.EnvVar{
+		{
+			Name:  "KUSION_S3_ENDPOINT",
+			Value: endpoint,
+		},
+		{
+			Name:  "KUSION_S3_BUCKET",
+			Value: objectStorage.Bucket,
+		},
+		{
+			Name:      "KUSION_S3_ACCESS_KEY_ID",
+			ValueFrom: secretEnvVarSource(credentialSecretName, "accessKeyID"),
+		},
+		{
+			Name:      "KUSION_S3_SECRET_ACCESS_KEY",
+			ValueFrom: secretEnvVarSource(credentialSecretName, "secretAccessKey"),
+		},
+	}
+	patcher := &apiv1.Patcher{
+		Environments: envVars,
+	}
+
+	return resources, patcher, nil
+}
+
+// secretEnvVarSource builds an EnvVarSource referencing a single key of a Kubernetes Secret.
+func secretEnvVarSource(secretName, key string) *v1.EnvVarSource {
+	return &v1.EnvVarSource{
+		SecretKeyRef: &v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{Name: secretName},
+			Key:                  key,
+		},
+	}
+}
+
+// generateLocalRandomPassword generates the random_password Terraform resource used as the MinIO
+// root/secret access key.
+func (objectStorage *ObjectStorage) generateLocalRandomPassword() (*apiv1.Resource, string, error) {
+	resAttrs := map[string]interface{}{
+		"length":  32,
+		"special": false,
+	}
+
+	id, err := module.TerraformResourceID(defaultRandomProviderCfg, localRandomPassword, objectStorage.Bucket)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resource, err := module.WrapTFResourceToKusionResource(defaultRandomProviderCfg, localRandomPassword, id, resAttrs, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return resource, id, nil
+}
+
+// generateLocalMinIOPVC generates the PersistentVolumeClaim backing the in-cluster MinIO data directory.
+func (objectStorage *ObjectStorage) generateLocalMinIOPVC(request *module.GeneratorRequest) (*apiv1.Resource, string, error) {
+	pvc := &v1.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PersistentVolumeClaim",
+			APIVersion: v1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      objectStorage.Bucket + "-minio-data",
+			Namespace: request.Project,
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+			Resources: v1.VolumeResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceStorage: apiresource.MustParse("10Gi"),
+				},
+			},
+		},
+	}
+
+	id := module.KubernetesResourceID(pvc.TypeMeta, pvc.ObjectMeta)
+	resource, err := module.WrapK8sResourceToKusionResource(id, pvc)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return resource, pvc.Name, nil
+}
+
+// generateLocalMinIODeployment generates the in-cluster MinIO server Deployment.
+func (objectStorage *ObjectStorage) generateLocalMinIODeployment(request *module.GeneratorRequest, credentialSecretName, pvcName string) (*apiv1.Resource, error) {
+	labels := map[string]string{"kusion.io/objectstorage": objectStorage.Bucket}
+
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      objectStorage.Bucket + "-minio",
+			Namespace: request.Project,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: int32Ptr(1),
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name:  "minio",
+							Image: localMinIOImage,
+							Args:  []string{"server", "/data"},
+							Ports: []v1.ContainerPort{{ContainerPort: localMinIOPort}},
+							Env: []v1.EnvVar{
+								{Name: "MINIO_ROOT_USER", ValueFrom: secretEnvVarSource(credentialSecretName, "accessKeyID")},
+								{Name: "MINIO_ROOT_PASSWORD", ValueFrom: secretEnvVarSource(credentialSecretName, "secretAccessKey")},
+							},
+							VolumeMounts: []v1.VolumeMount{
+								{Name: "data", MountPath: "/data"},
+							},
+						},
+					},
+					Volumes: []v1.Volume{
+						{
+							Name: "data",
+							VolumeSource: v1.VolumeSource{
+								PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: pvcName},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	id := module.KubernetesResourceID(deployment.TypeMeta, deployment.ObjectMeta)
+	resource, err := module.WrapK8sResourceToKusionResource(id, deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
+
+// generateLocalMinIOService generates the Kubernetes Service fronting the in-cluster MinIO deployment.
+func (objectStorage *ObjectStorage) generateLocalMinIOService(request *module.GeneratorRequest) (*apiv1.Resource, string, error) {
+	labels := map[string]string{"kusion.io/objectstorage": objectStorage.Bucket}
+
+	service := &v1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: v1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      objectStorage.Bucket + "-minio",
+			Namespace: request.Project,
+		},
+		Spec: v1.ServiceSpec{
+			Selector: labels,
+			Ports: []v1.ServicePort{
+				{
+					Name:       "api",
+					Port:       localMinIOPort,
+					TargetPort: intstr.FromInt32(localMinIOPort),
+				},
+			},
+		},
+	}
+
+	id := module.KubernetesResourceID(service.TypeMeta, service.ObjectMeta)
+	resource, err := module.WrapK8sResourceToKusionResource(id, service)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return resource, service.Name, nil
+}
+
+// generateLocalBucketBootstrapJob generates the bootstrap Job that creates the requested bucket on the
+// in-cluster MinIO instance using the `mc` client, since MinIO does not create buckets on startup.
+func (objectStorage *ObjectStorage) generateLocalBucketBootstrapJob(request *module.GeneratorRequest, credentialSecretName, endpoint string) (*apiv1.Resource, error) {
+	job := &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Job",
+			APIVersion: batchv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      objectStorage.Bucket + "-minio-bootstrap",
+			Namespace: request.Project,
+		},
+		Spec: batchv1.JobSpec{
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					RestartPolicy: v1.RestartPolicyOnFailure,
+					Containers: []v1.Container{
+						{
+							Name:  "mc",
+							Image: localMinIOMCImage,
+							Command: []string{
+								"sh",
+								"-c",
+								fmt.Sprintf("mc alias set local %s \"$MINIO_ROOT_USER\" \"$MINIO_ROOT_PASSWORD\" && mc mb --ignore-existing local/%s", endpoint, objectStorage.Bucket),
+							},
+							Env: []v1.EnvVar{
+								{Name: "MINIO_ROOT_USER", ValueFrom: secretEnvVarSource(credentialSecretName, "accessKeyID")},
+								{Name: "MINIO_ROOT_PASSWORD", ValueFrom: secretEnvVarSource(credentialSecretName, "secretAccessKey")},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	id := module.KubernetesResourceID(job.TypeMeta, job.ObjectMeta)
+	resource, err := module.WrapK8sResourceToKusionResource(id, job)
+	if err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
+
+// int32Ptr returns a pointer to the given int32, used for Kubernetes fields that take *int32.
+func int32Ptr(i int32) *int32 {
+	return &i
+}
@@ -19,8 +19,14 @@ func main() {
 }
 
 var (
-	ErrEmptyInstanceTypeForCloudDB = errors.New("empty instance type for cloud managed mysql instance")
-	ErrEmptyCloudProviderType      = errors.New("empty cloud provider type in mysql module config")
+	ErrEmptyInstanceTypeForCloudDB  = errors.New("empty instance type for cloud managed mysql instance")
+	ErrEmptyCloudProviderType       = errors.New("empty cloud provider type in mysql module config")
+	ErrKMSKeyIDWithoutSSEKMS        = errors.New("kmsKeyID is only valid when encryption sseAlgorithm is aws:kms")
+	ErrInvalidEncryptionAlgorithm   = errors.New("encryption sseAlgorithm must be AES256 or aws:kms")
+	ErrInvalidAccessMode            = errors.New("access mode must be one of readwrite, readonly or writeonly")
+	ErrEmptyOIDCProviderForIRSA     = errors.New("access oidcProvider is required when useIRSA is enabled")
+	ErrEmptyServiceAccountForIRSA   = errors.New("access serviceAccountName is required when useIRSA is enabled")
+	ErrEmptyBackupDestinationRegion = errors.New("backup destinationRegion is required")
 )
 
 // ObjectStorage implements the Kusion Module generator interface.
@@ -35,6 +41,93 @@ type ObjectStorage struct {
 	Type string `json:"type,omitempty" yaml:"type,omitempty"`
 
 	Bucket string `yaml:"bucket,omitempty" json:"bucket,omitempty"`
+
+	// Versioning enables bucket versioning.
+	Versioning bool `yaml:"versioning,omitempty" json:"versioning,omitempty"`
+
+	// Encryption configures server-side encryption for the bucket.
+	Encryption *Encryption `yaml:"encryption,omitempty" json:"encryption,omitempty"`
+
+	// Lifecycle configures the bucket's object lifecycle rules.
+	Lifecycle []LifecycleRule `yaml:"lifecycle,omitempty" json:"lifecycle,omitempty"`
+
+	// PublicAccessBlock blocks all forms of public access to the bucket.
+	PublicAccessBlock bool `yaml:"publicAccessBlock,omitempty" json:"publicAccessBlock,omitempty"`
+
+	// CORS configures the bucket's cross-origin resource sharing rules.
+	CORS []CORSRule `yaml:"cors,omitempty" json:"cors,omitempty"`
+
+	// Logging configures access logging to a target bucket.
+	Logging *Logging `yaml:"logging,omitempty" json:"logging,omitempty"`
+
+	// Tags are the provider-agnostic resource tags applied to the bucket.
+	Tags map[string]string `yaml:"tags,omitempty" json:"tags,omitempty"`
+
+	// Access configures the least-privilege IAM policy and role binding workloads use to reach the
+	// bucket, instead of relying on hard-coded access keys.
+	Access *Access `yaml:"access,omitempty" json:"access,omitempty"`
+
+	// Backup configures cross-region replication and scheduled snapshots for the bucket.
+	Backup *Backup `yaml:"backup,omitempty" json:"backup,omitempty"`
+}
+
+// Backup describes the cross-region replication and scheduled snapshot behavior for a bucket.
+// DestinationBucket may reference an existing bucket in DestinationRegion; when empty, a destination
+// bucket is created for the replication target. On Azure, where replication is scoped to the storage
+// account rather than the container, DestinationBucket instead identifies an existing destination
+// storage account name (requires AZURE_SUBSCRIPTION_ID to resolve its resource ID).
+type Backup struct {
+	Schedule          string `yaml:"schedule,omitempty" json:"schedule,omitempty"`
+	RetentionDays     int    `yaml:"retentionDays,omitempty" json:"retentionDays,omitempty"`
+	DestinationRegion string `yaml:"destinationRegion,omitempty" json:"destinationRegion,omitempty"`
+	DestinationBucket string `yaml:"destinationBucket,omitempty" json:"destinationBucket,omitempty"`
+}
+
+// Access describes the least-privilege access workloads are granted to the bucket. Mode is one of
+// "readwrite", "readonly" or "writeonly". When UseIRSA (or the equivalent RRSA/Workload Identity
+// mechanism on other clouds) is enabled, OIDCProvider identifies the cluster's OIDC identity provider
+// that the generated role trusts, and ServiceAccountName is the Kubernetes ServiceAccount the workload
+// runs as - it is required in that case so the generated trust policy/binding can restrict federation
+// to that exact ServiceAccount instead of trusting every identity the OIDC provider can vouch for.
+type Access struct {
+	Mode               string   `yaml:"mode,omitempty" json:"mode,omitempty"`
+	Principals         []string `yaml:"principals,omitempty" json:"principals,omitempty"`
+	UseIRSA            bool     `yaml:"useIRSA,omitempty" json:"useIRSA,omitempty"`
+	OIDCProvider       string   `yaml:"oidcProvider,omitempty" json:"oidcProvider,omitempty"`
+	ServiceAccountName string   `yaml:"serviceAccountName,omitempty" json:"serviceAccountName,omitempty"`
+}
+
+// Encryption configures server-side encryption for a bucket. SSEAlgorithm is either "AES256" for
+// SSE-S3 or "aws:kms" for SSE-KMS, in which case KMSKeyID may optionally reference a customer
+// managed key.
+type Encryption struct {
+	SSEAlgorithm string `yaml:"sseAlgorithm,omitempty" json:"sseAlgorithm,omitempty"`
+	KMSKeyID     string `yaml:"kmsKeyID,omitempty" json:"kmsKeyID,omitempty"`
+}
+
+// LifecycleRule describes a single bucket lifecycle rule.
+type LifecycleRule struct {
+	ID                                 string `yaml:"id,omitempty" json:"id,omitempty"`
+	Prefix                             string `yaml:"prefix,omitempty" json:"prefix,omitempty"`
+	ExpirationDays                     int    `yaml:"expirationDays,omitempty" json:"expirationDays,omitempty"`
+	TransitionDays                     int    `yaml:"transitionDays,omitempty" json:"transitionDays,omitempty"`
+	StorageClass                       string `yaml:"storageClass,omitempty" json:"storageClass,omitempty"`
+	AbortIncompleteMultipartUploadDays int    `yaml:"abortIncompleteMultipartUploadDays,omitempty" json:"abortIncompleteMultipartUploadDays,omitempty"`
+}
+
+// CORSRule describes a single bucket CORS rule.
+type CORSRule struct {
+	AllowedOrigins []string `yaml:"allowedOrigins,omitempty" json:"allowedOrigins,omitempty"`
+	AllowedMethods []string `yaml:"allowedMethods,omitempty" json:"allowedMethods,omitempty"`
+	AllowedHeaders []string `yaml:"allowedHeaders,omitempty" json:"allowedHeaders,omitempty"`
+	ExposeHeaders  []string `yaml:"exposeHeaders,omitempty" json:"exposeHeaders,omitempty"`
+	MaxAgeSeconds  int      `yaml:"maxAgeSeconds,omitempty" json:"maxAgeSeconds,omitempty"`
+}
+
+// Logging configures bucket access logging to a target bucket and prefix.
+type Logging struct {
+	TargetBucket string `yaml:"targetBucket,omitempty" json:"targetBucket,omitempty"`
+	TargetPrefix string `yaml:"targetPrefix,omitempty" json:"targetPrefix,omitempty"`
 }
 
 // Generate implements the generation logic of objectStorage module, including a Kubernetes Service and
@@ -68,8 +161,12 @@ func (objectStorage *ObjectStorage) Generate(_ context.Context, request *module.
 
 	// var providerType string
 	switch strings.ToLower(objectStorage.Type) {
-	// case "local":
-	// 	resources, patcher, err = mysql.GenerateLocalResources(request)
+	case "local":
+		var err error
+		resources, patcher, err = objectStorage.GenerateLocalResources(request)
+		if err != nil {
+			return nil, err
+		}
 	case "cloud":
 		providerType, err := GetCloudProviderType(request.PlatformConfig)
 		if err != nil {
@@ -82,11 +179,21 @@ func (objectStorage *ObjectStorage) Generate(_ context.Context, request *module.
 			if err != nil {
 				return nil, err
 			}
-		// case "alicloud":
-		// 	resources, patcher, err = mysql.GenerateAlicloudResources(request)
-		// 	if err != nil {
-		// 		return nil, err
-		// 	}
+		case "alicloud":
+			resources, patcher, err = objectStorage.GenerateAlicloudResources(request)
+			if err != nil {
+				return nil, err
+			}
+		case "azure":
+			resources, patcher, err = objectStorage.GenerateAzureResources(request)
+			if err != nil {
+				return nil, err
+			}
+		case "gcp":
+			resources, patcher, err = objectStorage.GenerateGCPResources(request)
+			if err != nil {
+				return nil, err
+			}
 		default:
 			return nil, fmt.Errorf("unsupported cloud provider type: %s", providerType)
 		}
@@ -144,5 +251,37 @@ func (objectStorage *ObjectStorage) CompleteConfig(devConfig apiv1.Accessory, pl
 
 // ValidateConfig validates the completed objectStorage configs are valid or not.
 func (objectStorage *ObjectStorage) ValidateConfig() error {
+	if objectStorage.Encryption != nil {
+		switch objectStorage.Encryption.SSEAlgorithm {
+		case "aws:kms":
+		case "AES256", "":
+			if objectStorage.Encryption.KMSKeyID != "" {
+				return ErrKMSKeyIDWithoutSSEKMS
+			}
+		default:
+			return ErrInvalidEncryptionAlgorithm
+		}
+	}
+
+	if objectStorage.Access != nil {
+		switch strings.ToLower(objectStorage.Access.Mode) {
+		case "readwrite", "readonly", "writeonly", "":
+		default:
+			return ErrInvalidAccessMode
+		}
+
+		if objectStorage.Access.UseIRSA && objectStorage.Access.OIDCProvider == "" {
+			return ErrEmptyOIDCProviderForIRSA
+		}
+
+		if objectStorage.Access.UseIRSA && objectStorage.Access.ServiceAccountName == "" {
+			return ErrEmptyServiceAccountForIRSA
+		}
+	}
+
+	if objectStorage.Backup != nil && objectStorage.Backup.DestinationRegion == "" {
+		return ErrEmptyBackupDestinationRegion
+	}
+
 	return nil
 }
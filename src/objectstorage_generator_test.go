@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"kusionstack.io/kusion-module-framework/pkg/module"
+	apiv1 "kusionstack.io/kusion/pkg/apis/api.kusion.io/v1"
+	"kusionstack.io/kusion/pkg/workspace"
+)
+
+// TestGenerate_CloudBranches exercises the "cloud" branch of Generate for every supported provider,
+// asserting that each emits at least one resource and wires the provider-normalized endpoint env var
+// into the returned Patcher.
+func TestGenerate_CloudBranches(t *testing.T) {
+	cases := []struct {
+		name        string
+		cloud       string
+		envVars     map[string]string
+		endpointEnv string
+	}{
+		{
+			name:        "aws",
+			cloud:       "aws",
+			envVars:     map[string]string{"AWS_REGION": "us-east-1"},
+			endpointEnv: "KUSION_AWS_S3_BUCKET_DOMAIN_NAME",
+		},
+		{
+			name:        "alicloud",
+			cloud:       "alicloud",
+			envVars:     map[string]string{"ALICLOUD_REGION": "cn-hangzhou"},
+			endpointEnv: "KUSION_ALICLOUD_OSS_BUCKET_ENDPOINT",
+		},
+		{
+			name:  "azure",
+			cloud: "azure",
+			envVars: map[string]string{
+				"AZURE_LOCATION":       "eastus",
+				"AZURE_RESOURCE_GROUP": "rg-test",
+			},
+			endpointEnv: "KUSION_AZURE_BLOB_ENDPOINT",
+		},
+		{
+			name:  "gcp",
+			cloud: "gcp",
+			envVars: map[string]string{
+				"GOOGLE_REGION":  "us-central1",
+				"GOOGLE_PROJECT": "test-project",
+			},
+			endpointEnv: "KUSION_GCP_GCS_BUCKET_URL",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for k, v := range tc.envVars {
+				t.Setenv(k, v)
+			}
+
+			objectStorage := &ObjectStorage{
+				Type:   "cloud",
+				Bucket: "test-bucket",
+			}
+			request := &module.GeneratorRequest{
+				Project: "test-project",
+				PlatformConfig: apiv1.GenericConfig{
+					"cloud": tc.cloud,
+				},
+			}
+
+			response, err := objectStorage.Generate(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Generate() returned unexpected error: %v", err)
+			}
+
+			if len(response.Resources) == 0 {
+				t.Fatalf("Generate() returned no resources for cloud %q", tc.cloud)
+			}
+
+			if response.Patcher == nil {
+				t.Fatalf("Generate() returned a nil Patcher for cloud %q", tc.cloud)
+			}
+
+			found := false
+			for _, env := range response.Patcher.Environments {
+				if env.Name == tc.endpointEnv {
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Errorf("Generate() Patcher missing expected env var %q for cloud %q", tc.endpointEnv, tc.cloud)
+			}
+		})
+	}
+}
+
+// TestGenerate_LocalType exercises the "local" branch of Generate, asserting it emits the in-cluster
+// MinIO resources and wires the S3-compatible endpoint env vars into the returned Patcher without
+// requiring any cloud provider config.
+func TestGenerate_LocalType(t *testing.T) {
+	objectStorage := &ObjectStorage{
+		Type:   "local",
+		Bucket: "test-bucket",
+	}
+	request := &module.GeneratorRequest{
+		Project: "test-project",
+	}
+
+	response, err := objectStorage.Generate(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Generate() returned unexpected error: %v", err)
+	}
+
+	if len(response.Resources) == 0 {
+		t.Fatalf("Generate() returned no resources for local type")
+	}
+
+	if response.Patcher == nil {
+		t.Fatalf("Generate() returned a nil Patcher for local type")
+	}
+
+	wantEnvVars := map[string]bool{
+		"KUSION_S3_ENDPOINT": false,
+		"KUSION_S3_BUCKET":   false,
+	}
+	for _, env := range response.Patcher.Environments {
+		if _, ok := wantEnvVars[env.Name]; ok {
+			wantEnvVars[env.Name] = true
+		}
+	}
+	for name, found := range wantEnvVars {
+		if !found {
+			t.Errorf("Generate() Patcher missing expected env var %q for local type", name)
+		}
+	}
+}
+
+// TestValidateConfig covers the error branches of ValidateConfig across encryption, access and backup
+// configs.
+func TestValidateConfig(t *testing.T) {
+	cases := []struct {
+		name    string
+		storage *ObjectStorage
+		wantErr error
+	}{
+		{
+			name:    "valid empty config",
+			storage: &ObjectStorage{},
+			wantErr: nil,
+		},
+		{
+			name:    "valid sse-kms encryption",
+			storage: &ObjectStorage{Encryption: &Encryption{SSEAlgorithm: "aws:kms", KMSKeyID: "test-key"}},
+			wantErr: nil,
+		},
+		{
+			name:    "kms key id without sse-kms",
+			storage: &ObjectStorage{Encryption: &Encryption{SSEAlgorithm: "AES256", KMSKeyID: "test-key"}},
+			wantErr: ErrKMSKeyIDWithoutSSEKMS,
+		},
+		{
+			name:    "invalid encryption algorithm",
+			storage: &ObjectStorage{Encryption: &Encryption{SSEAlgorithm: "invalid"}},
+			wantErr: ErrInvalidEncryptionAlgorithm,
+		},
+		{
+			name:    "invalid access mode",
+			storage: &ObjectStorage{Access: &Access{Mode: "invalid"}},
+			wantErr: ErrInvalidAccessMode,
+		},
+		{
+			name:    "useIRSA without oidcProvider",
+			storage: &ObjectStorage{Access: &Access{UseIRSA: true, ServiceAccountName: "test-sa"}},
+			wantErr: ErrEmptyOIDCProviderForIRSA,
+		},
+		{
+			name:    "useIRSA without serviceAccountName",
+			storage: &ObjectStorage{Access: &Access{UseIRSA: true, OIDCProvider: "test-oidc"}},
+			wantErr: ErrEmptyServiceAccountForIRSA,
+		},
+		{
+			name: "valid useIRSA access",
+			storage: &ObjectStorage{Access: &Access{
+				UseIRSA:            true,
+				OIDCProvider:       "test-oidc",
+				ServiceAccountName: "test-sa",
+			}},
+			wantErr: nil,
+		},
+		{
+			name:    "backup without destinationRegion",
+			storage: &ObjectStorage{Backup: &Backup{}},
+			wantErr: ErrEmptyBackupDestinationRegion,
+		},
+		{
+			name:    "valid backup",
+			storage: &ObjectStorage{Backup: &Backup{DestinationRegion: "us-east-2"}},
+			wantErr: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.storage.ValidateConfig(); err != tc.wantErr {
+				t.Errorf("ValidateConfig() error = %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+// TestGetCloudProviderType verifies the cloud provider type is read from the platform config and that
+// a missing config block or missing cloud key surfaces the expected errors.
+func TestGetCloudProviderType(t *testing.T) {
+	cloudType, err := GetCloudProviderType(apiv1.GenericConfig{"cloud": "aws"})
+	if err != nil {
+		t.Fatalf("GetCloudProviderType() returned unexpected error: %v", err)
+	}
+	if cloudType != "aws" {
+		t.Errorf("GetCloudProviderType() = %q, want %q", cloudType, "aws")
+	}
+
+	if _, err := GetCloudProviderType(nil); err != workspace.ErrEmptyModuleConfigBlock {
+		t.Errorf("GetCloudProviderType(nil) error = %v, want %v", err, workspace.ErrEmptyModuleConfigBlock)
+	}
+
+	if _, err := GetCloudProviderType(apiv1.GenericConfig{}); err != ErrEmptyCloudProviderType {
+		t.Errorf("GetCloudProviderType({}) error = %v, want %v", err, ErrEmptyCloudProviderType)
+	}
+}
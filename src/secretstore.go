@@ -0,0 +1,402 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"kusionstack.io/kusion-module-framework/pkg/module"
+	apiv1 "kusionstack.io/kusion/pkg/apis/api.kusion.io/v1"
+	"kusionstack.io/kusion/pkg/modules"
+)
+
+var ErrUnsupportedSecretStoreProvider = errors.New("unsupported workspace secret store provider")
+
+var (
+	awsSecretsManagerSecret        = "aws_secretsmanager_secret"
+	awsSecretsManagerSecretVersion = "aws_secretsmanager_secret_version"
+	vaultKVSecretV2                = "vault_kv_secret_v2"
+	azurermKeyVaultSecret          = "azurerm_key_vault_secret"
+	alicloudKMSSecret              = "alicloud_kms_secret"
+	googleSecretManagerSecret      = "google_secret_manager_secret"
+	googleSecretManagerSecretVer   = "google_secret_manager_secret_version"
+)
+
+// generateCredentialSecret materializes the given credential key/value pairs as something a workload
+// can mount as env vars. When the workspace configures a SecretStore, the credentials are written to
+// the external secret backend and an ExternalSecret is generated so the rendered manifests never
+// carry the raw values; otherwise it falls back to an inline Kubernetes Secret. It returns the
+// resources to emit and the name of the Kubernetes Secret workloads should reference via
+// secretKeyRef - either the inline one, or the one the ExternalSecret controller materializes.
+func (objectStorage *ObjectStorage) generateCredentialSecret(request *module.GeneratorRequest, name string, data map[string]string) ([]apiv1.Resource, string, error) {
+	secretStore := request.SecretStore
+
+	if secretStore.Provider == nil {
+		return objectStorage.generateInlineCredentialSecret(request, name, data)
+	}
+
+	return objectStorage.generateExternalCredentialSecret(request, &secretStore, name, data)
+}
+
+// generateInlineCredentialSecret is the fallback behavior used when no workspace SecretStore is
+// configured: it writes the credentials directly into a Kubernetes Secret.
+func (objectStorage *ObjectStorage) generateInlineCredentialSecret(request *module.GeneratorRequest, name string, data map[string]string) ([]apiv1.Resource, string, error) {
+	secret := &v1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: v1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: request.Project,
+		},
+		StringData: data,
+	}
+
+	id := module.KubernetesResourceID(secret.TypeMeta, secret.ObjectMeta)
+	resource, err := module.WrapK8sResourceToKusionResource(id, secret)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return []apiv1.Resource{*resource}, name, nil
+}
+
+// toJSONString marshals the credential data to a JSON string for backends that accept a single
+// opaque secret value rather than a key/value map.
+func toJSONString(data map[string]string) string {
+	encoded, _ := json.Marshal(data)
+	return string(encoded)
+}
+
+// generateExternalCredentialSecret writes the credentials to the workspace-configured SecretStore
+// backend and generates a SecretStore and an ExternalSecret so the Kubernetes Secret workloads
+// consume is synced from there instead of being rendered with plain-text values.
+func (objectStorage *ObjectStorage) generateExternalCredentialSecret(request *module.GeneratorRequest, secretStore *apiv1.SecretStore, name string, data map[string]string) ([]apiv1.Resource, string, error) {
+	var resources []apiv1.Resource
+
+	remoteKeyPrefix, providerResources, err := objectStorage.generateSecretStoreProviderResources(secretStore, name, data)
+	if err != nil {
+		return nil, "", err
+	}
+	resources = append(resources, providerResources...)
+
+	backendStore := newExternalSecretStore(request.Project, name, secretStore.Provider)
+	backendStoreID := module.KubernetesResourceID(metav1.TypeMeta{
+		Kind:       backendStore.GetKind(),
+		APIVersion: backendStore.GetAPIVersion(),
+	}, metav1.ObjectMeta{Name: backendStore.GetName(), Namespace: backendStore.GetNamespace()})
+	backendStoreResource, err := module.WrapK8sResourceToKusionResource(backendStoreID, backendStore)
+	if err != nil {
+		return nil, "", err
+	}
+	resources = append(resources, *backendStoreResource)
+
+	externalSecret := newExternalSecret(request.Project, name, remoteKeyPrefix, data)
+	externalSecretID := module.KubernetesResourceID(metav1.TypeMeta{
+		Kind:       externalSecret.GetKind(),
+		APIVersion: externalSecret.GetAPIVersion(),
+	}, metav1.ObjectMeta{Name: externalSecret.GetName(), Namespace: externalSecret.GetNamespace()})
+	resource, err := module.WrapK8sResourceToKusionResource(externalSecretID, externalSecret)
+	if err != nil {
+		return nil, "", err
+	}
+	resources = append(resources, *resource)
+
+	return resources, name, nil
+}
+
+// generateSecretStoreProviderResources emits the provider-specific secret resources holding the
+// credential data, and returns the remote key prefix the ExternalSecret should reference.
+func (objectStorage *ObjectStorage) generateSecretStoreProviderResources(secretStore *apiv1.SecretStore, name string, data map[string]string) (string, []apiv1.Resource, error) {
+	provider := secretStore.Provider
+
+	switch {
+	case provider.Vault != nil:
+		return objectStorage.generateVaultKVSecret(provider.Vault, name, data)
+	case provider.AWS != nil:
+		return objectStorage.generateAWSSecretsManagerSecret(name, data)
+	case provider.Azure != nil:
+		return objectStorage.generateAzureKeyVaultSecret(provider.Azure, name, data)
+	case provider.Alicloud != nil:
+		return objectStorage.generateAlicloudKMSSecret(name, data)
+	case provider.GCPSM != nil:
+		return objectStorage.generateGoogleSecretManagerSecret(provider.GCPSM, name, data)
+	default:
+		return "", nil, ErrUnsupportedSecretStoreProvider
+	}
+}
+
+// generateVaultKVSecret generates a vault_kv_secret_v2 resource holding the credential data.
+func (objectStorage *ObjectStorage) generateVaultKVSecret(vault *apiv1.VaultProvider, name string, data map[string]string) (string, []apiv1.Resource, error) {
+	vaultProviderCfg := module.ProviderConfig{
+		Source:  "hashicorp/vault",
+		Version: "4.4.0",
+	}
+	vaultProviderCfg.ProviderMeta = map[string]any{"address": vault.Server}
+
+	mount := "secret"
+	if vault.Path != nil && *vault.Path != "" {
+		mount = *vault.Path
+	}
+
+	secretData := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		secretData[k] = v
+	}
+
+	resAttrs := map[string]interface{}{
+		"mount":     mount,
+		"name":      name,
+		"data_json": secretData,
+	}
+
+	id, err := module.TerraformResourceID(vaultProviderCfg, vaultKVSecretV2, name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	resource, err := module.WrapTFResourceToKusionResource(vaultProviderCfg, vaultKVSecretV2, id, resAttrs, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return mount + "/" + name, []apiv1.Resource{*resource}, nil
+}
+
+// generateAWSSecretsManagerSecret generates the aws_secretsmanager_secret and
+// aws_secretsmanager_secret_version resources holding the credential data as a single JSON blob.
+func (objectStorage *ObjectStorage) generateAWSSecretsManagerSecret(name string, data map[string]string) (string, []apiv1.Resource, error) {
+	awsProviderCfg := defaultAWSProviderCfg
+
+	var resources []apiv1.Resource
+
+	secretAttrs := map[string]interface{}{
+		"name": name,
+	}
+
+	secretID, err := module.TerraformResourceID(awsProviderCfg, awsSecretsManagerSecret, name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	secret, err := module.WrapTFResourceToKusionResource(awsProviderCfg, awsSecretsManagerSecret, secretID, secretAttrs, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	resources = append(resources, *secret)
+
+	versionAttrs := map[string]interface{}{
+		"secret_id":     modules.KusionPathDependency(secretID, "id"),
+		"secret_string": toJSONString(data),
+	}
+
+	versionID, err := module.TerraformResourceID(awsProviderCfg, awsSecretsManagerSecretVersion, name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	version, err := module.WrapTFResourceToKusionResource(awsProviderCfg, awsSecretsManagerSecretVersion, versionID, versionAttrs, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	resources = append(resources, *version)
+
+	return name, resources, nil
+}
+
+// generateAzureKeyVaultSecret generates the azurerm_key_vault_secret resource holding the credential
+// data as a single JSON blob.
+func (objectStorage *ObjectStorage) generateAzureKeyVaultSecret(azure *apiv1.AzureKVProvider, name string, data map[string]string) (string, []apiv1.Resource, error) {
+	var vaultURL string
+	if azure.VaultURL != nil {
+		vaultURL = *azure.VaultURL
+	}
+
+	resAttrs := map[string]interface{}{
+		"name":         name,
+		"value":        toJSONString(data),
+		"key_vault_id": vaultURL,
+	}
+
+	id, err := module.TerraformResourceID(defaultAzureProviderCfg, azurermKeyVaultSecret, name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	resource, err := module.WrapTFResourceToKusionResource(defaultAzureProviderCfg, azurermKeyVaultSecret, id, resAttrs, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return name, []apiv1.Resource{*resource}, nil
+}
+
+// generateAlicloudKMSSecret generates the alicloud_kms_secret resource holding the credential data as
+// a single JSON blob.
+func (objectStorage *ObjectStorage) generateAlicloudKMSSecret(name string, data map[string]string) (string, []apiv1.Resource, error) {
+	resAttrs := map[string]interface{}{
+		"secret_name": name,
+		"secret_data": toJSONString(data),
+	}
+
+	id, err := module.TerraformResourceID(defaultAlicloudProviderCfg, alicloudKMSSecret, name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	resource, err := module.WrapTFResourceToKusionResource(defaultAlicloudProviderCfg, alicloudKMSSecret, id, resAttrs, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return name, []apiv1.Resource{*resource}, nil
+}
+
+// generateGoogleSecretManagerSecret generates the google_secret_manager_secret and
+// google_secret_manager_secret_version resources holding the credential data as a single JSON blob.
+func (objectStorage *ObjectStorage) generateGoogleSecretManagerSecret(gcpsm *apiv1.GCPSMProvider, name string, data map[string]string) (string, []apiv1.Resource, error) {
+	var resources []apiv1.Resource
+
+	gcpProviderCfg := defaultGCPProviderCfg
+	if gcpsm.ProjectID != nil {
+		gcpProviderCfg.ProviderMeta = map[string]any{"project": *gcpsm.ProjectID}
+	}
+
+	secretAttrs := map[string]interface{}{
+		"secret_id": name,
+		"replication": map[string]interface{}{
+			"auto": map[string]interface{}{},
+		},
+	}
+
+	secretID, err := module.TerraformResourceID(gcpProviderCfg, googleSecretManagerSecret, name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	secret, err := module.WrapTFResourceToKusionResource(gcpProviderCfg, googleSecretManagerSecret, secretID, secretAttrs, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	resources = append(resources, *secret)
+
+	versionAttrs := map[string]interface{}{
+		"secret":      modules.KusionPathDependency(secretID, "id"),
+		"secret_data": toJSONString(data),
+	}
+
+	versionID, err := module.TerraformResourceID(gcpProviderCfg, googleSecretManagerSecretVer, name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	version, err := module.WrapTFResourceToKusionResource(gcpProviderCfg, googleSecretManagerSecretVer, versionID, versionAttrs, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	resources = append(resources, *version)
+
+	return name, resources, nil
+}
+
+// newExternalSecret builds the external-secrets.io ExternalSecret custom resource that syncs
+// remoteKey from the configured SecretStore into a Kubernetes Secret named secretName.
+func newExternalSecret(namespace, secretName, remoteKey string, data map[string]string) *unstructured.Unstructured {
+	dataEntries := make([]interface{}, 0, len(data))
+	for key := range data {
+		dataEntries = append(dataEntries, map[string]interface{}{
+			"secretKey": key,
+			"remoteRef": map[string]interface{}{
+				"key":      remoteKey,
+				"property": key,
+			},
+		})
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "external-secrets.io/v1beta1",
+			"kind":       "ExternalSecret",
+			"metadata": map[string]interface{}{
+				"name":      secretName,
+				"namespace": namespace,
+			},
+			"spec": map[string]interface{}{
+				"refreshInterval": "1h",
+				"secretStoreRef": map[string]interface{}{
+					"name": secretName + "-store",
+					"kind": "SecretStore",
+				},
+				"target": map[string]interface{}{
+					"name": secretName,
+				},
+				"data": dataEntries,
+			},
+		},
+	}
+}
+
+// newExternalSecretStore builds the external-secrets.io SecretStore custom resource that the
+// generated ExternalSecret's secretStoreRef points at, so the external-secrets operator has a backend
+// to resolve the referenced remote key against.
+func newExternalSecretStore(namespace, secretName string, provider *apiv1.ProviderSpec) *unstructured.Unstructured {
+	var providerSpec map[string]interface{}
+	switch {
+	case provider.Vault != nil:
+		version := "v2"
+		if provider.Vault.Version != "" {
+			version = string(provider.Vault.Version)
+		}
+		providerSpec = map[string]interface{}{
+			"vault": map[string]interface{}{
+				"server":  provider.Vault.Server,
+				"version": version,
+			},
+		}
+	case provider.AWS != nil:
+		providerSpec = map[string]interface{}{
+			"aws": map[string]interface{}{
+				"service": "SecretsManager",
+				"region":  provider.AWS.Region,
+			},
+		}
+	case provider.Azure != nil:
+		azureSpec := map[string]interface{}{}
+		if provider.Azure.VaultURL != nil {
+			azureSpec["vaultUrl"] = *provider.Azure.VaultURL
+		}
+		if provider.Azure.TenantID != nil {
+			azureSpec["tenantId"] = *provider.Azure.TenantID
+		}
+		providerSpec = map[string]interface{}{"azurekv": azureSpec}
+	case provider.Alicloud != nil:
+		providerSpec = map[string]interface{}{
+			"alicloud": map[string]interface{}{
+				"regionID": provider.Alicloud.Region,
+			},
+		}
+	case provider.GCPSM != nil:
+		gcpsmSpec := map[string]interface{}{}
+		if provider.GCPSM.ProjectID != nil {
+			gcpsmSpec["projectID"] = *provider.GCPSM.ProjectID
+		}
+		providerSpec = map[string]interface{}{"gcpsm": gcpsmSpec}
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "external-secrets.io/v1beta1",
+			"kind":       "SecretStore",
+			"metadata": map[string]interface{}{
+				"name":      secretName + "-store",
+				"namespace": namespace,
+			},
+			"spec": map[string]interface{}{
+				"provider": providerSpec,
+			},
+		},
+	}
+}